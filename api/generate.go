@@ -0,0 +1,6 @@
+// Package api holds protobuf definitions of the gsloc services which are not (yet) part of
+// gsloc-api and gsloc-go-sdk. Imported definitions (entries, healthchecks, validate rules)
+// must be resolvable from gsloc-api when running generation.
+package api
+
+//go:generate buf generate
@@ -0,0 +1,5323 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: gsloc/services/ops/v1/ops.proto
+
+package opssvc
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	v1 "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	v11 "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/healthchecks/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EntryRevision_Action int32
+
+const (
+	EntryRevision_SET      EntryRevision_Action = 0
+	EntryRevision_DELETE   EntryRevision_Action = 1
+	EntryRevision_ROLLBACK EntryRevision_Action = 2
+)
+
+// Enum value maps for EntryRevision_Action.
+var (
+	EntryRevision_Action_name = map[int32]string{
+		0: "SET",
+		1: "DELETE",
+		2: "ROLLBACK",
+	}
+	EntryRevision_Action_value = map[string]int32{
+		"SET":      0,
+		"DELETE":   1,
+		"ROLLBACK": 2,
+	}
+)
+
+func (x EntryRevision_Action) Enum() *EntryRevision_Action {
+	p := new(EntryRevision_Action)
+	*p = x
+	return p
+}
+
+func (x EntryRevision_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntryRevision_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_gsloc_services_ops_v1_ops_proto_enumTypes[0].Descriptor()
+}
+
+func (EntryRevision_Action) Type() protoreflect.EnumType {
+	return &file_gsloc_services_ops_v1_ops_proto_enumTypes[0]
+}
+
+func (x EntryRevision_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntryRevision_Action.Descriptor instead.
+func (EntryRevision_Action) EnumDescriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type ImportOptions_Mode int32
+
+const (
+	// set imported entries and keep the others
+	ImportOptions_MERGE ImportOptions_Mode = 0
+	// set imported entries and delete entries in scope which are not imported
+	ImportOptions_REPLACE ImportOptions_Mode = 1
+	// only set imported entries which does not exist yet
+	ImportOptions_SKIP_EXISTING ImportOptions_Mode = 2
+)
+
+// Enum value maps for ImportOptions_Mode.
+var (
+	ImportOptions_Mode_name = map[int32]string{
+		0: "MERGE",
+		1: "REPLACE",
+		2: "SKIP_EXISTING",
+	}
+	ImportOptions_Mode_value = map[string]int32{
+		"MERGE":         0,
+		"REPLACE":       1,
+		"SKIP_EXISTING": 2,
+	}
+)
+
+func (x ImportOptions_Mode) Enum() *ImportOptions_Mode {
+	p := new(ImportOptions_Mode)
+	*p = x
+	return p
+}
+
+func (x ImportOptions_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportOptions_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_gsloc_services_ops_v1_ops_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportOptions_Mode) Type() protoreflect.EnumType {
+	return &file_gsloc_services_ops_v1_ops_proto_enumTypes[1]
+}
+
+func (x ImportOptions_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportOptions_Mode.Descriptor instead.
+func (ImportOptions_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type EntryChange_Action int32
+
+const (
+	EntryChange_CREATE EntryChange_Action = 0
+	EntryChange_UPDATE EntryChange_Action = 1
+	EntryChange_DELETE EntryChange_Action = 2
+)
+
+// Enum value maps for EntryChange_Action.
+var (
+	EntryChange_Action_name = map[int32]string{
+		0: "CREATE",
+		1: "UPDATE",
+		2: "DELETE",
+	}
+	EntryChange_Action_value = map[string]int32{
+		"CREATE": 0,
+		"UPDATE": 1,
+		"DELETE": 2,
+	}
+)
+
+func (x EntryChange_Action) Enum() *EntryChange_Action {
+	p := new(EntryChange_Action)
+	*p = x
+	return p
+}
+
+func (x EntryChange_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntryChange_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_gsloc_services_ops_v1_ops_proto_enumTypes[2].Descriptor()
+}
+
+func (EntryChange_Action) Type() protoreflect.EnumType {
+	return &file_gsloc_services_ops_v1_ops_proto_enumTypes[2]
+}
+
+func (x EntryChange_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntryChange_Action.Descriptor instead.
+func (EntryChange_Action) EnumDescriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type MemberSignal_Level int32
+
+const (
+	MemberSignal_LEVEL_UNSPECIFIED MemberSignal_Level = 0
+	// member is only used when no member without signal is left
+	MemberSignal_DEGRADED MemberSignal_Level = 1
+	// member is never used
+	MemberSignal_FAILED MemberSignal_Level = 2
+)
+
+// Enum value maps for MemberSignal_Level.
+var (
+	MemberSignal_Level_name = map[int32]string{
+		0: "LEVEL_UNSPECIFIED",
+		1: "DEGRADED",
+		2: "FAILED",
+	}
+	MemberSignal_Level_value = map[string]int32{
+		"LEVEL_UNSPECIFIED": 0,
+		"DEGRADED":          1,
+		"FAILED":            2,
+	}
+)
+
+func (x MemberSignal_Level) Enum() *MemberSignal_Level {
+	p := new(MemberSignal_Level)
+	*p = x
+	return p
+}
+
+func (x MemberSignal_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemberSignal_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_gsloc_services_ops_v1_ops_proto_enumTypes[3].Descriptor()
+}
+
+func (MemberSignal_Level) Type() protoreflect.EnumType {
+	return &file_gsloc_services_ops_v1_ops_proto_enumTypes[3]
+}
+
+func (x MemberSignal_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemberSignal_Level.Descriptor instead.
+func (MemberSignal_Level) EnumDescriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{44, 0}
+}
+
+type EntryRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision    uint64                 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Author      string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Action      EntryRevision_Action   `protobuf:"varint,4,opt,name=action,proto3,enum=gsloc.services.ops.v1.EntryRevision_Action" json:"action,omitempty"`
+	SignedEntry *v1.SignedEntry        `protobuf:"bytes,5,opt,name=signed_entry,json=signedEntry,proto3" json:"signed_entry,omitempty"`
+	// revision used as source when action is rollback
+	RollbackFrom uint64 `protobuf:"varint,6,opt,name=rollback_from,json=rollbackFrom,proto3" json:"rollback_from,omitempty"`
+}
+
+func (x *EntryRevision) Reset() {
+	*x = EntryRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntryRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryRevision) ProtoMessage() {}
+
+func (x *EntryRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryRevision.ProtoReflect.Descriptor instead.
+func (*EntryRevision) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EntryRevision) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *EntryRevision) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *EntryRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EntryRevision) GetAction() EntryRevision_Action {
+	if x != nil {
+		return x.Action
+	}
+	return EntryRevision_SET
+}
+
+func (x *EntryRevision) GetSignedEntry() *v1.SignedEntry {
+	if x != nil {
+		return x.SignedEntry
+	}
+	return nil
+}
+
+func (x *EntryRevision) GetRollbackFrom() uint64 {
+	if x != nil {
+		return x.RollbackFrom
+	}
+	return 0
+}
+
+type ListEntryRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdn string `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+}
+
+func (x *ListEntryRevisionsRequest) Reset() {
+	*x = ListEntryRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEntryRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntryRevisionsRequest) ProtoMessage() {}
+
+func (x *ListEntryRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntryRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListEntryRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListEntryRevisionsRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+type ListEntryRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*EntryRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *ListEntryRevisionsResponse) Reset() {
+	*x = ListEntryRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEntryRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntryRevisionsResponse) ProtoMessage() {}
+
+func (x *ListEntryRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntryRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListEntryRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListEntryRevisionsResponse) GetRevisions() []*EntryRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type DiffEntryRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdn         string `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	FromRevision uint64 `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	// if not set, diff is made against current entry
+	ToRevision uint64 `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+}
+
+func (x *DiffEntryRevisionsRequest) Reset() {
+	*x = DiffEntryRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffEntryRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffEntryRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffEntryRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffEntryRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffEntryRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DiffEntryRevisionsRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *DiffEntryRevisionsRequest) GetFromRevision() uint64 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffEntryRevisionsRequest) GetToRevision() uint64 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+type DiffEntryRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unified diff of the json representation of both revisions
+	Diff string         `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	From *EntryRevision `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   *EntryRevision `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *DiffEntryRevisionsResponse) Reset() {
+	*x = DiffEntryRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffEntryRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffEntryRevisionsResponse) ProtoMessage() {}
+
+func (x *DiffEntryRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffEntryRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffEntryRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DiffEntryRevisionsResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *DiffEntryRevisionsResponse) GetFrom() *EntryRevision {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DiffEntryRevisionsResponse) GetTo() *EntryRevision {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type RollbackEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdn     string `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *RollbackEntryRequest) Reset() {
+	*x = RollbackEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackEntryRequest) ProtoMessage() {}
+
+func (x *RollbackEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackEntryRequest.ProtoReflect.Descriptor instead.
+func (*RollbackEntryRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RollbackEntryRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *RollbackEntryRequest) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// common name of verified client certificate, remote address when caller gave no certificate
+	Caller string `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	// full grpc method name called
+	Method  string   `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Fqdns   []string `protobuf:"bytes,4,rep,name=fqdns,proto3" json:"fqdns,omitempty"`
+	Members []string `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
+	// unified diff of targeted entries, or of their policies on policy calls, before and after the call
+	Diff    string `protobuf:"bytes,6,opt,name=diff,proto3" json:"diff,omitempty"`
+	Success bool   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AuditEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetFqdns() []string {
+	if x != nil {
+		return x.Fqdns
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AuditEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Since *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Until *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	Fqdn  string                 `protobuf:"bytes,3,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	// maximum number of events returned, the latest are kept. 0 means the latest 1000 events
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAuditEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ResignEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Tags   []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ResignEntriesRequest) Reset() {
+	*x = ResignEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResignEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResignEntriesRequest) ProtoMessage() {}
+
+func (x *ResignEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResignEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ResignEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResignEntriesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ResignEntriesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ResignEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdns []string `protobuf:"bytes,1,rep,name=fqdns,proto3" json:"fqdns,omitempty"`
+}
+
+func (x *ResignEntriesResponse) Reset() {
+	*x = ResignEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResignEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResignEntriesResponse) ProtoMessage() {}
+
+func (x *ResignEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResignEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ResignEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ResignEntriesResponse) GetFqdns() []string {
+	if x != nil {
+		return x.Fqdns
+	}
+	return nil
+}
+
+type ExportEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Tags   []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ExportEntriesRequest) Reset() {
+	*x = ExportEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEntriesRequest) ProtoMessage() {}
+
+func (x *ExportEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ExportEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ExportEntriesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ExportEntriesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ImportOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode ImportOptions_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=gsloc.services.ops.v1.ImportOptions_Mode" json:"mode,omitempty"`
+	// scope of import, imported entries must match it and entries in scope are deleted in replace mode
+	Prefix string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Tags   []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	DryRun bool     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ImportOptions) GetMode() ImportOptions_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return ImportOptions_MERGE
+}
+
+func (x *ImportOptions) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Request:
+	//	*ImportEntriesRequest_Options
+	//	*ImportEntriesRequest_SignedEntry
+	Request isImportEntriesRequest_Request `protobuf_oneof:"request"`
+}
+
+func (x *ImportEntriesRequest) Reset() {
+	*x = ImportEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEntriesRequest) ProtoMessage() {}
+
+func (x *ImportEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ImportEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *ImportEntriesRequest) GetRequest() isImportEntriesRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *ImportEntriesRequest) GetOptions() *ImportOptions {
+	if x, ok := x.GetRequest().(*ImportEntriesRequest_Options); ok {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ImportEntriesRequest) GetSignedEntry() *v1.SignedEntry {
+	if x, ok := x.GetRequest().(*ImportEntriesRequest_SignedEntry); ok {
+		return x.SignedEntry
+	}
+	return nil
+}
+
+type isImportEntriesRequest_Request interface {
+	isImportEntriesRequest_Request()
+}
+
+type ImportEntriesRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportEntriesRequest_SignedEntry struct {
+	SignedEntry *v1.SignedEntry `protobuf:"bytes,2,opt,name=signed_entry,json=signedEntry,proto3,oneof"`
+}
+
+func (*ImportEntriesRequest_Options) isImportEntriesRequest_Request() {}
+
+func (*ImportEntriesRequest_SignedEntry) isImportEntriesRequest_Request() {}
+
+type ImportEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created []string `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Updated []string `protobuf:"bytes,2,rep,name=updated,proto3" json:"updated,omitempty"`
+	Deleted []string `protobuf:"bytes,3,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Skipped []string `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	// entries not applied, changes are applied in several transactions and one fails as a whole
+	// when one of its entries has been changed meanwhile
+	Failed []string `protobuf:"bytes,5,rep,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *ImportEntriesResponse) Reset() {
+	*x = ImportEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEntriesResponse) ProtoMessage() {}
+
+func (x *ImportEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ImportEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportEntriesResponse) GetCreated() []string {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *ImportEntriesResponse) GetUpdated() []string {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *ImportEntriesResponse) GetDeleted() []string {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+func (x *ImportEntriesResponse) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *ImportEntriesResponse) GetFailed() []string {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+type PlanOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// only entries with fqdn starting with prefix are managed
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// only entries having all these tags are managed
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// delete managed entries which are not desired, otherwise they are only reported as unmanaged
+	DeleteUnmanaged bool `protobuf:"varint,3,opt,name=delete_unmanaged,json=deleteUnmanaged,proto3" json:"delete_unmanaged,omitempty"`
+	// apply changes after planning them
+	Apply bool `protobuf:"varint,4,opt,name=apply,proto3" json:"apply,omitempty"`
+}
+
+func (x *PlanOptions) Reset() {
+	*x = PlanOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanOptions) ProtoMessage() {}
+
+func (x *PlanOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanOptions.ProtoReflect.Descriptor instead.
+func (*PlanOptions) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PlanOptions) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *PlanOptions) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PlanOptions) GetDeleteUnmanaged() bool {
+	if x != nil {
+		return x.DeleteUnmanaged
+	}
+	return false
+}
+
+func (x *PlanOptions) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+type PlanEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Request:
+	//	*PlanEntriesRequest_Options
+	//	*PlanEntriesRequest_SignedEntry
+	Request isPlanEntriesRequest_Request `protobuf_oneof:"request"`
+}
+
+func (x *PlanEntriesRequest) Reset() {
+	*x = PlanEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanEntriesRequest) ProtoMessage() {}
+
+func (x *PlanEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanEntriesRequest.ProtoReflect.Descriptor instead.
+func (*PlanEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{16}
+}
+
+func (m *PlanEntriesRequest) GetRequest() isPlanEntriesRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *PlanEntriesRequest) GetOptions() *PlanOptions {
+	if x, ok := x.GetRequest().(*PlanEntriesRequest_Options); ok {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *PlanEntriesRequest) GetSignedEntry() *v1.SignedEntry {
+	if x, ok := x.GetRequest().(*PlanEntriesRequest_SignedEntry); ok {
+		return x.SignedEntry
+	}
+	return nil
+}
+
+type isPlanEntriesRequest_Request interface {
+	isPlanEntriesRequest_Request()
+}
+
+type PlanEntriesRequest_Options struct {
+	Options *PlanOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type PlanEntriesRequest_SignedEntry struct {
+	SignedEntry *v1.SignedEntry `protobuf:"bytes,2,opt,name=signed_entry,json=signedEntry,proto3,oneof"`
+}
+
+func (*PlanEntriesRequest_Options) isPlanEntriesRequest_Request() {}
+
+func (*PlanEntriesRequest_SignedEntry) isPlanEntriesRequest_Request() {}
+
+type EntryChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdn   string             `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	Action EntryChange_Action `protobuf:"varint,2,opt,name=action,proto3,enum=gsloc.services.ops.v1.EntryChange_Action" json:"action,omitempty"`
+	// unified diff of the json representation of current and desired entry
+	Diff string `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *EntryChange) Reset() {
+	*x = EntryChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntryChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryChange) ProtoMessage() {}
+
+func (x *EntryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryChange.ProtoReflect.Descriptor instead.
+func (*EntryChange) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EntryChange) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *EntryChange) GetAction() EntryChange_Action {
+	if x != nil {
+		return x.Action
+	}
+	return EntryChange_CREATE
+}
+
+func (x *EntryChange) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type PlanEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*EntryChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// entries in scope which are not desired and kept as is
+	Unmanaged []string `protobuf:"bytes,2,rep,name=unmanaged,proto3" json:"unmanaged,omitempty"`
+	// true when changes have been applied, except those of failed entries
+	Applied bool `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"`
+	// entries not applied, see ImportEntriesResponse.failed
+	Failed []string `protobuf:"bytes,4,rep,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *PlanEntriesResponse) Reset() {
+	*x = PlanEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanEntriesResponse) ProtoMessage() {}
+
+func (x *PlanEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanEntriesResponse.ProtoReflect.Descriptor instead.
+func (*PlanEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PlanEntriesResponse) GetChanges() []*EntryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *PlanEntriesResponse) GetUnmanaged() []string {
+	if x != nil {
+		return x.Unmanaged
+	}
+	return nil
+}
+
+func (x *PlanEntriesResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *PlanEntriesResponse) GetFailed() []string {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+type FlapDamping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of state changes in window after which state changes are suppressed, 0 disables damping
+	MaxFlaps uint32               `protobuf:"varint,1,opt,name=max_flaps,json=maxFlaps,proto3" json:"max_flaps,omitempty"`
+	Window   *durationpb.Duration `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// how long state is kept as is once damped, window is used if not set
+	Suppress *durationpb.Duration `protobuf:"bytes,3,opt,name=suppress,proto3" json:"suppress,omitempty"`
+}
+
+func (x *FlapDamping) Reset() {
+	*x = FlapDamping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlapDamping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlapDamping) ProtoMessage() {}
+
+func (x *FlapDamping) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlapDamping.ProtoReflect.Descriptor instead.
+func (*FlapDamping) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FlapDamping) GetMaxFlaps() uint32 {
+	if x != nil {
+		return x.MaxFlaps
+	}
+	return 0
+}
+
+func (x *FlapDamping) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *FlapDamping) GetSuppress() *durationpb.Duration {
+	if x != nil {
+		return x.Suppress
+	}
+	return nil
+}
+
+type HealthPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// consecutive successful probes needed to set back an unhealthy member as healthy, 1 if not set
+	HealthyThreshold uint32 `protobuf:"varint,1,opt,name=healthy_threshold,json=healthyThreshold,proto3" json:"healthy_threshold,omitempty"`
+	// consecutive failed probes needed to set a healthy member as unhealthy, 1 if not set
+	UnhealthyThreshold uint32           `protobuf:"varint,2,opt,name=unhealthy_threshold,json=unhealthyThreshold,proto3" json:"unhealthy_threshold,omitempty"`
+	FlapDamping        *FlapDamping     `protobuf:"bytes,3,opt,name=flap_damping,json=flapDamping,proto3" json:"flap_damping,omitempty"`
+	Tls                *HealthTlsPolicy `protobuf:"bytes,4,opt,name=tls,proto3" json:"tls,omitempty"`
+	// when set, every dc probes members of the entry and a member is unhealthy only when
+	// this number of dcs see it unhealthy, member dc included. 0 lets member dc decide alone
+	CrossDcQuorum uint32 `protobuf:"varint,5,opt,name=cross_dc_quorum,json=crossDcQuorum,proto3" json:"cross_dc_quorum,omitempty"`
+}
+
+func (x *HealthPolicy) Reset() {
+	*x = HealthPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthPolicy) ProtoMessage() {}
+
+func (x *HealthPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthPolicy.ProtoReflect.Descriptor instead.
+func (*HealthPolicy) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HealthPolicy) GetHealthyThreshold() uint32 {
+	if x != nil {
+		return x.HealthyThreshold
+	}
+	return 0
+}
+
+func (x *HealthPolicy) GetUnhealthyThreshold() uint32 {
+	if x != nil {
+		return x.UnhealthyThreshold
+	}
+	return 0
+}
+
+func (x *HealthPolicy) GetFlapDamping() *FlapDamping {
+	if x != nil {
+		return x.FlapDamping
+	}
+	return nil
+}
+
+func (x *HealthPolicy) GetTls() *HealthTlsPolicy {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *HealthPolicy) GetCrossDcQuorum() uint32 {
+	if x != nil {
+		return x.CrossDcQuorum
+	}
+	return 0
+}
+
+// HealthTlsPolicy completes tls config of health checks when tls is enabled.
+type HealthTlsPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client certificate in pem format, or a reference resolved by the probing node
+	// as secret:<name> for a file named name in healthcheck secrets directory.
+	// Inline values are stored in consul kv, references should be preferred for keys.
+	ClientCert string `protobuf:"bytes,1,opt,name=client_cert,json=clientCert,proto3" json:"client_cert,omitempty"`
+	// client private key in pem format or a reference as for client_cert
+	ClientKey string `protobuf:"bytes,2,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	// minimum tls version, one of TLS1.0, TLS1.1, TLS1.2 or TLS1.3
+	MinVersion string `protobuf:"bytes,3,opt,name=min_version,json=minVersion,proto3" json:"min_version,omitempty"`
+	// cipher suites allowed for tls 1.2 and lower, as named by go crypto/tls, e.g. TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	// insecure cipher suites are rejected
+	CipherSuites []string `protobuf:"bytes,4,rep,name=cipher_suites,json=cipherSuites,proto3" json:"cipher_suites,omitempty"`
+}
+
+func (x *HealthTlsPolicy) Reset() {
+	*x = HealthTlsPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthTlsPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthTlsPolicy) ProtoMessage() {}
+
+func (x *HealthTlsPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthTlsPolicy.ProtoReflect.Descriptor instead.
+func (*HealthTlsPolicy) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *HealthTlsPolicy) GetClientCert() string {
+	if x != nil {
+		return x.ClientCert
+	}
+	return ""
+}
+
+func (x *HealthTlsPolicy) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+func (x *HealthTlsPolicy) GetMinVersion() string {
+	if x != nil {
+		return x.MinVersion
+	}
+	return ""
+}
+
+func (x *HealthTlsPolicy) GetCipherSuites() []string {
+	if x != nil {
+		return x.CipherSuites
+	}
+	return nil
+}
+
+// AdaptiveWeightPolicy makes member ratios computed periodically from a metric scraped by proxy metrics targets
+// of each dc instead of using static ratios.
+type AdaptiveWeightPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of metric, e.g. node_load1
+	Metric string `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	// label of metric giving member ip, a port in its value is ignored, default to instance
+	IpLabel string `protobuf:"bytes,2,opt,name=ip_label,json=ipLabel,proto3" json:"ip_label,omitempty"`
+	// metric is a load (lower is better, e.g. cpu or active connections) unless set
+	HigherIsBetter bool `protobuf:"varint,3,opt,name=higher_is_better,json=higherIsBetter,proto3" json:"higher_is_better,omitempty"`
+	// default to 1
+	MinWeight uint32 `protobuf:"varint,4,opt,name=min_weight,json=minWeight,proto3" json:"min_weight,omitempty"`
+	// default to 100
+	MaxWeight uint32 `protobuf:"varint,5,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	// factor of exponential moving average applied on metric, 1 means no smoothing, default to 0.3
+	Smoothing float64 `protobuf:"fixed64,6,opt,name=smoothing,proto3" json:"smoothing,omitempty"`
+}
+
+func (x *AdaptiveWeightPolicy) Reset() {
+	*x = AdaptiveWeightPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdaptiveWeightPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdaptiveWeightPolicy) ProtoMessage() {}
+
+func (x *AdaptiveWeightPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdaptiveWeightPolicy.ProtoReflect.Descriptor instead.
+func (*AdaptiveWeightPolicy) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AdaptiveWeightPolicy) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *AdaptiveWeightPolicy) GetIpLabel() string {
+	if x != nil {
+		return x.IpLabel
+	}
+	return ""
+}
+
+func (x *AdaptiveWeightPolicy) GetHigherIsBetter() bool {
+	if x != nil {
+		return x.HigherIsBetter
+	}
+	return false
+}
+
+func (x *AdaptiveWeightPolicy) GetMinWeight() uint32 {
+	if x != nil {
+		return x.MinWeight
+	}
+	return 0
+}
+
+func (x *AdaptiveWeightPolicy) GetMaxWeight() uint32 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *AdaptiveWeightPolicy) GetSmoothing() float64 {
+	if x != nil {
+		return x.Smoothing
+	}
+	return 0
+}
+
+// PriorityGroupPolicy puts members in priority groups, answers are only made with members of the highest priority
+// group having enough active members, traffic spills to the next group otherwise.
+type PriorityGroupPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*PriorityGroupPolicy_Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// minimum number of active members for a group to be used, default to 1
+	MinActiveMembers uint32 `protobuf:"varint,2,opt,name=min_active_members,json=minActiveMembers,proto3" json:"min_active_members,omitempty"`
+	// minimum percent of active members among all members of a group for a group to be used
+	MinActivePercent uint32 `protobuf:"varint,3,opt,name=min_active_percent,json=minActivePercent,proto3" json:"min_active_percent,omitempty"`
+}
+
+func (x *PriorityGroupPolicy) Reset() {
+	*x = PriorityGroupPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriorityGroupPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriorityGroupPolicy) ProtoMessage() {}
+
+func (x *PriorityGroupPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriorityGroupPolicy.ProtoReflect.Descriptor instead.
+func (*PriorityGroupPolicy) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PriorityGroupPolicy) GetGroups() []*PriorityGroupPolicy_Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *PriorityGroupPolicy) GetMinActiveMembers() uint32 {
+	if x != nil {
+		return x.MinActiveMembers
+	}
+	return 0
+}
+
+func (x *PriorityGroupPolicy) GetMinActivePercent() uint32 {
+	if x != nil {
+		return x.MinActivePercent
+	}
+	return 0
+}
+
+// SlowStartPolicy makes ratio of members coming back active grow linearly during a window
+// instead of giving them their full share of answers at once, it only has effect with ratio lb algorithm
+// and it is not applied when client affinity is set.
+type SlowStartPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window *durationpb.Duration `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	// percent of ratio given to a member when it comes back, default to 10
+	MinPercent uint32 `protobuf:"varint,2,opt,name=min_percent,json=minPercent,proto3" json:"min_percent,omitempty"`
+}
+
+func (x *SlowStartPolicy) Reset() {
+	*x = SlowStartPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlowStartPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlowStartPolicy) ProtoMessage() {}
+
+func (x *SlowStartPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlowStartPolicy.ProtoReflect.Descriptor instead.
+func (*SlowStartPolicy) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SlowStartPolicy) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *SlowStartPolicy) GetMinPercent() uint32 {
+	if x != nil {
+		return x.MinPercent
+	}
+	return 0
+}
+
+// ClientAffinityPolicy makes clients of a same subnet always get the same member while it is active,
+// member is chosen by consistent hashing of client subnet weighted by ratio in place of preferred lb algorithm of entry.
+// Subnet given by edns client subnet is used when it is smaller than the configured one.
+type ClientAffinityPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// prefix length of ipv4 client subnets, default to 24
+	Ipv4PrefixLength uint32 `protobuf:"varint,1,opt,name=ipv4_prefix_length,json=ipv4PrefixLength,proto3" json:"ipv4_prefix_length,omitempty"`
+	// prefix length of ipv6 client subnets, default to 56
+	Ipv6PrefixLength uint32 `protobuf:"varint,2,opt,name=ipv6_prefix_length,json=ipv6PrefixLength,proto3" json:"ipv6_prefix_length,omitempty"`
+}
+
+func (x *ClientAffinityPolicy) Reset() {
+	*x = ClientAffinityPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientAffinityPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientAffinityPolicy) ProtoMessage() {}
+
+func (x *ClientAffinityPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientAffinityPolicy.ProtoReflect.Descriptor instead.
+func (*ClientAffinityPolicy) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ClientAffinityPolicy) GetIpv4PrefixLength() uint32 {
+	if x != nil {
+		return x.Ipv4PrefixLength
+	}
+	return 0
+}
+
+func (x *ClientAffinityPolicy) GetIpv6PrefixLength() uint32 {
+	if x != nil {
+		return x.Ipv6PrefixLength
+	}
+	return 0
+}
+
+type EntryPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdn            string                `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	Health          *HealthPolicy         `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"`
+	AdaptiveWeights *AdaptiveWeightPolicy `protobuf:"bytes,3,opt,name=adaptive_weights,json=adaptiveWeights,proto3" json:"adaptive_weights,omitempty"`
+	PriorityGroups  *PriorityGroupPolicy  `protobuf:"bytes,4,opt,name=priority_groups,json=priorityGroups,proto3" json:"priority_groups,omitempty"`
+	SlowStart       *SlowStartPolicy      `protobuf:"bytes,5,opt,name=slow_start,json=slowStart,proto3" json:"slow_start,omitempty"`
+	ClientAffinity  *ClientAffinityPolicy `protobuf:"bytes,6,opt,name=client_affinity,json=clientAffinity,proto3" json:"client_affinity,omitempty"`
+}
+
+func (x *EntryPolicy) Reset() {
+	*x = EntryPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryPolicy) ProtoMessage() {}
+
+func (x *EntryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryPolicy.ProtoReflect.Descriptor instead.
+func (*EntryPolicy) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EntryPolicy) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *EntryPolicy) GetHealth() *HealthPolicy {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *EntryPolicy) GetAdaptiveWeights() *AdaptiveWeightPolicy {
+	if x != nil {
+		return x.AdaptiveWeights
+	}
+	return nil
+}
+
+func (x *EntryPolicy) GetPriorityGroups() *PriorityGroupPolicy {
+	if x != nil {
+		return x.PriorityGroups
+	}
+	return nil
+}
+
+func (x *EntryPolicy) GetSlowStart() *SlowStartPolicy {
+	if x != nil {
+		return x.SlowStart
+	}
+	return nil
+}
+
+func (x *EntryPolicy) GetClientAffinity() *ClientAffinityPolicy {
+	if x != nil {
+		return x.ClientAffinity
+	}
+	return nil
+}
+
+type SetEntryPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *EntryPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetEntryPolicyRequest) Reset() {
+	*x = SetEntryPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetEntryPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEntryPolicyRequest) ProtoMessage() {}
+
+func (x *SetEntryPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEntryPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetEntryPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetEntryPolicyRequest) GetPolicy() *EntryPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type GetEntryPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdn string `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+}
+
+func (x *GetEntryPolicyRequest) Reset() {
+	*x = GetEntryPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEntryPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntryPolicyRequest) ProtoMessage() {}
+
+func (x *GetEntryPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntryPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetEntryPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetEntryPolicyRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+type DeleteEntryPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdn string `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+}
+
+func (x *DeleteEntryPolicyRequest) Reset() {
+	*x = DeleteEntryPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEntryPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntryPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteEntryPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntryPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEntryPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteEntryPolicyRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+type ProbeRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Duration  *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Success   bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Error     string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ProbeRecord) Reset() {
+	*x = ProbeRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeRecord) ProtoMessage() {}
+
+func (x *ProbeRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeRecord.ProtoReflect.Descriptor instead.
+func (*ProbeRecord) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ProbeRecord) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ProbeRecord) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *ProbeRecord) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProbeRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// MemberHealthState is the state of a member given by rise/fall thresholds and flap damping.
+type MemberHealthState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// health decided by cross dc quorum when entry uses it
+	Healthy              bool   `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	ConsecutiveSuccesses uint32 `protobuf:"varint,2,opt,name=consecutive_successes,json=consecutiveSuccesses,proto3" json:"consecutive_successes,omitempty"`
+	ConsecutiveFailures  uint32 `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	HealthyThreshold     uint32 `protobuf:"varint,4,opt,name=healthy_threshold,json=healthyThreshold,proto3" json:"healthy_threshold,omitempty"`
+	UnhealthyThreshold   uint32 `protobuf:"varint,5,opt,name=unhealthy_threshold,json=unhealthyThreshold,proto3" json:"unhealthy_threshold,omitempty"`
+	// set while state changes are damped
+	DampedUntil *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=damped_until,json=dampedUntil,proto3" json:"damped_until,omitempty"`
+	// set for members of the receiving node dc when entry uses cross dc quorum
+	CrossDc *CrossDcHealth `protobuf:"bytes,7,opt,name=cross_dc,json=crossDc,proto3" json:"cross_dc,omitempty"`
+}
+
+func (x *MemberHealthState) Reset() {
+	*x = MemberHealthState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberHealthState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberHealthState) ProtoMessage() {}
+
+func (x *MemberHealthState) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberHealthState.ProtoReflect.Descriptor instead.
+func (*MemberHealthState) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MemberHealthState) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *MemberHealthState) GetConsecutiveSuccesses() uint32 {
+	if x != nil {
+		return x.ConsecutiveSuccesses
+	}
+	return 0
+}
+
+func (x *MemberHealthState) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *MemberHealthState) GetHealthyThreshold() uint32 {
+	if x != nil {
+		return x.HealthyThreshold
+	}
+	return 0
+}
+
+func (x *MemberHealthState) GetUnhealthyThreshold() uint32 {
+	if x != nil {
+		return x.UnhealthyThreshold
+	}
+	return 0
+}
+
+func (x *MemberHealthState) GetDampedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DampedUntil
+	}
+	return nil
+}
+
+func (x *MemberHealthState) GetCrossDc() *CrossDcHealth {
+	if x != nil {
+		return x.CrossDc
+	}
+	return nil
+}
+
+// DcHealth is health of a member seen by a dc.
+type DcHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dc      string `protobuf:"bytes,1,opt,name=dc,proto3" json:"dc,omitempty"`
+	Healthy bool   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// output of the last probe made by dc, empty for the receiving node dc
+	Output string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *DcHealth) Reset() {
+	*x = DcHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DcHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DcHealth) ProtoMessage() {}
+
+func (x *DcHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DcHealth.ProtoReflect.Descriptor instead.
+func (*DcHealth) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DcHealth) GetDc() string {
+	if x != nil {
+		return x.Dc
+	}
+	return ""
+}
+
+func (x *DcHealth) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *DcHealth) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// CrossDcHealth is health of a member decided by dcs which probed it.
+type CrossDcHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Healthy bool `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// number of dcs which see member unhealthy
+	Unhealthy uint32 `protobuf:"varint,2,opt,name=unhealthy,proto3" json:"unhealthy,omitempty"`
+	// number of dcs which must see member unhealthy, it is lowered to number of dcs with a known health
+	Quorum uint32      `protobuf:"varint,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	Dcs    []*DcHealth `protobuf:"bytes,4,rep,name=dcs,proto3" json:"dcs,omitempty"`
+}
+
+func (x *CrossDcHealth) Reset() {
+	*x = CrossDcHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrossDcHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossDcHealth) ProtoMessage() {}
+
+func (x *CrossDcHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrossDcHealth.ProtoReflect.Descriptor instead.
+func (*CrossDcHealth) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CrossDcHealth) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *CrossDcHealth) GetUnhealthy() uint32 {
+	if x != nil {
+		return x.Unhealthy
+	}
+	return 0
+}
+
+func (x *CrossDcHealth) GetQuorum() uint32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
+}
+
+func (x *CrossDcHealth) GetDcs() []*DcHealth {
+	if x != nil {
+		return x.Dcs
+	}
+	return nil
+}
+
+type MemberHealthHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip     string             `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Dc     string             `protobuf:"bytes,2,opt,name=dc,proto3" json:"dc,omitempty"`
+	Probes []*ProbeRecord     `protobuf:"bytes,3,rep,name=probes,proto3" json:"probes,omitempty"`
+	State  *MemberHealthState `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *MemberHealthHistory) Reset() {
+	*x = MemberHealthHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberHealthHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberHealthHistory) ProtoMessage() {}
+
+func (x *MemberHealthHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberHealthHistory.ProtoReflect.Descriptor instead.
+func (*MemberHealthHistory) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MemberHealthHistory) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *MemberHealthHistory) GetDc() string {
+	if x != nil {
+		return x.Dc
+	}
+	return ""
+}
+
+func (x *MemberHealthHistory) GetProbes() []*ProbeRecord {
+	if x != nil {
+		return x.Probes
+	}
+	return nil
+}
+
+func (x *MemberHealthHistory) GetState() *MemberHealthState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type GetMemberHealthHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdn string `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	// if not set, history of all members probed by the receiving node is given
+	Ip string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+}
+
+func (x *GetMemberHealthHistoryRequest) Reset() {
+	*x = GetMemberHealthHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMemberHealthHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberHealthHistoryRequest) ProtoMessage() {}
+
+func (x *GetMemberHealthHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberHealthHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberHealthHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetMemberHealthHistoryRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *GetMemberHealthHistoryRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type GetMemberHealthHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*MemberHealthHistory `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *GetMemberHealthHistoryResponse) Reset() {
+	*x = GetMemberHealthHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMemberHealthHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberHealthHistoryResponse) ProtoMessage() {}
+
+func (x *GetMemberHealthHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberHealthHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMemberHealthHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetMemberHealthHistoryResponse) GetMembers() []*MemberHealthHistory {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type DcMemberHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip      string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Healthy bool   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Output  string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *DcMemberHealth) Reset() {
+	*x = DcMemberHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DcMemberHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DcMemberHealth) ProtoMessage() {}
+
+func (x *DcMemberHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DcMemberHealth.ProtoReflect.Descriptor instead.
+func (*DcMemberHealth) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DcMemberHealth) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *DcMemberHealth) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *DcMemberHealth) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// DcHealthReport gives health of members of an entry seen by a dc which is not theirs, it is used for cross dc quorum.
+type DcHealthReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dc string `protobuf:"bytes,1,opt,name=dc,proto3" json:"dc,omitempty"`
+	// report is ignored after this time, dc which made it may be down
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Members   []*DcMemberHealth      `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *DcHealthReport) Reset() {
+	*x = DcHealthReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DcHealthReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DcHealthReport) ProtoMessage() {}
+
+func (x *DcHealthReport) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DcHealthReport.ProtoReflect.Descriptor instead.
+func (*DcHealthReport) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DcHealthReport) GetDc() string {
+	if x != nil {
+		return x.Dc
+	}
+	return ""
+}
+
+func (x *DcHealthReport) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *DcHealthReport) GetMembers() []*DcMemberHealth {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type TestHealthCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fqdn used by health check, e.g. as default tls server name, entry does not need to exist
+	Fqdn        string           `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	Healthcheck *v11.HealthCheck `protobuf:"bytes,2,opt,name=healthcheck,proto3" json:"healthcheck,omitempty"`
+	Ips         []string         `protobuf:"bytes,3,rep,name=ips,proto3" json:"ips,omitempty"`
+}
+
+func (x *TestHealthCheckRequest) Reset() {
+	*x = TestHealthCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestHealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestHealthCheckRequest) ProtoMessage() {}
+
+func (x *TestHealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestHealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*TestHealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TestHealthCheckRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *TestHealthCheckRequest) GetHealthcheck() *v11.HealthCheck {
+	if x != nil {
+		return x.Healthcheck
+	}
+	return nil
+}
+
+func (x *TestHealthCheckRequest) GetIps() []string {
+	if x != nil {
+		return x.Ips
+	}
+	return nil
+}
+
+type MemberCheckResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip       string               `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Success  bool                 `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Duration *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Error    string               `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// details given by some checks when they succeed, e.g. composite checks
+	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *MemberCheckResult) Reset() {
+	*x = MemberCheckResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberCheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberCheckResult) ProtoMessage() {}
+
+func (x *MemberCheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberCheckResult.ProtoReflect.Descriptor instead.
+func (*MemberCheckResult) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MemberCheckResult) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *MemberCheckResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MemberCheckResult) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *MemberCheckResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MemberCheckResult) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type TestHealthCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*MemberCheckResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *TestHealthCheckResponse) Reset() {
+	*x = TestHealthCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestHealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestHealthCheckResponse) ProtoMessage() {}
+
+func (x *TestHealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestHealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*TestHealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TestHealthCheckResponse) GetResults() []*MemberCheckResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type RecheckMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdn string `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	Ip   string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+}
+
+func (x *RecheckMemberRequest) Reset() {
+	*x = RecheckMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecheckMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecheckMemberRequest) ProtoMessage() {}
+
+func (x *RecheckMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecheckMemberRequest.ProtoReflect.Descriptor instead.
+func (*RecheckMemberRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RecheckMemberRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *RecheckMemberRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type RecheckMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// health after recheck, according to entry health policy
+	Healthy bool `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// output as given to consul
+	Output   string               `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	Duration *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *RecheckMemberResponse) Reset() {
+	*x = RecheckMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecheckMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecheckMemberResponse) ProtoMessage() {}
+
+func (x *RecheckMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecheckMemberResponse.ProtoReflect.Descriptor instead.
+func (*RecheckMemberResponse) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RecheckMemberResponse) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *RecheckMemberResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *RecheckMemberResponse) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// MemberSignal is a passive health signal reported on a member by an external system.
+type MemberSignal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdn   string             `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	Ip     string             `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Level  MemberSignal_Level `protobuf:"varint,3,opt,name=level,proto3,enum=gsloc.services.ops.v1.MemberSignal_Level" json:"level,omitempty"`
+	Reason string             `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// caller which reported signal
+	Reporter   string                 `protobuf:"bytes,5,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	ReportedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+	ExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// signature made by api node when signing keys are configured, retrievers ignore signals
+	// which can not be verified when signing is enforced
+	Signature string `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *MemberSignal) Reset() {
+	*x = MemberSignal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberSignal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberSignal) ProtoMessage() {}
+
+func (x *MemberSignal) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberSignal.ProtoReflect.Descriptor instead.
+func (*MemberSignal) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MemberSignal) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *MemberSignal) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *MemberSignal) GetLevel() MemberSignal_Level {
+	if x != nil {
+		return x.Level
+	}
+	return MemberSignal_LEVEL_UNSPECIFIED
+}
+
+func (x *MemberSignal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MemberSignal) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+func (x *MemberSignal) GetReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return nil
+}
+
+func (x *MemberSignal) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *MemberSignal) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type ReportMemberSignalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdn   string             `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	Ip     string             `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Level  MemberSignal_Level `protobuf:"varint,3,opt,name=level,proto3,enum=gsloc.services.ops.v1.MemberSignal_Level" json:"level,omitempty"`
+	Reason string             `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// time during which signal applies
+	Duration *durationpb.Duration `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *ReportMemberSignalRequest) Reset() {
+	*x = ReportMemberSignalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportMemberSignalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportMemberSignalRequest) ProtoMessage() {}
+
+func (x *ReportMemberSignalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportMemberSignalRequest.ProtoReflect.Descriptor instead.
+func (*ReportMemberSignalRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReportMemberSignalRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *ReportMemberSignalRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ReportMemberSignalRequest) GetLevel() MemberSignal_Level {
+	if x != nil {
+		return x.Level
+	}
+	return MemberSignal_LEVEL_UNSPECIFIED
+}
+
+func (x *ReportMemberSignalRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReportMemberSignalRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type ClearMemberSignalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdn string `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	Ip   string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+}
+
+func (x *ClearMemberSignalRequest) Reset() {
+	*x = ClearMemberSignalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearMemberSignalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearMemberSignalRequest) ProtoMessage() {}
+
+func (x *ClearMemberSignalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearMemberSignalRequest.ProtoReflect.Descriptor instead.
+func (*ClearMemberSignalRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ClearMemberSignalRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *ClearMemberSignalRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type ListMemberSignalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// if not set, signals of all entries are given
+	Fqdn string `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+}
+
+func (x *ListMemberSignalsRequest) Reset() {
+	*x = ListMemberSignalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMemberSignalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberSignalsRequest) ProtoMessage() {}
+
+func (x *ListMemberSignalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberSignalsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemberSignalsRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListMemberSignalsRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+type ListMemberSignalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signals []*MemberSignal `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty"`
+}
+
+func (x *ListMemberSignalsResponse) Reset() {
+	*x = ListMemberSignalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMemberSignalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberSignalsResponse) ProtoMessage() {}
+
+func (x *ListMemberSignalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberSignalsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemberSignalsResponse) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListMemberSignalsResponse) GetSignals() []*MemberSignal {
+	if x != nil {
+		return x.Signals
+	}
+	return nil
+}
+
+type MemberWeight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip     string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// smoothed metric value from which weight has been computed
+	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MemberWeight) Reset() {
+	*x = MemberWeight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberWeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberWeight) ProtoMessage() {}
+
+func (x *MemberWeight) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberWeight.ProtoReflect.Descriptor instead.
+func (*MemberWeight) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MemberWeight) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *MemberWeight) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *MemberWeight) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// EffectiveWeights are weights computed by a dc for its members of an entry.
+type EffectiveWeights struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqdn       string                 `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	Dc         string                 `protobuf:"bytes,2,opt,name=dc,proto3" json:"dc,omitempty"`
+	ComputedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	// static ratios are used again after this time, dc which computed weights may be down
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Members   []*MemberWeight        `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *EffectiveWeights) Reset() {
+	*x = EffectiveWeights{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EffectiveWeights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectiveWeights) ProtoMessage() {}
+
+func (x *EffectiveWeights) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectiveWeights.ProtoReflect.Descriptor instead.
+func (*EffectiveWeights) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *EffectiveWeights) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *EffectiveWeights) GetDc() string {
+	if x != nil {
+		return x.Dc
+	}
+	return ""
+}
+
+func (x *EffectiveWeights) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+func (x *EffectiveWeights) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *EffectiveWeights) GetMembers() []*MemberWeight {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ListEffectiveWeightsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// if not set, weights of all entries are given
+	Fqdn string `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+}
+
+func (x *ListEffectiveWeightsRequest) Reset() {
+	*x = ListEffectiveWeightsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEffectiveWeightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEffectiveWeightsRequest) ProtoMessage() {}
+
+func (x *ListEffectiveWeightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEffectiveWeightsRequest.ProtoReflect.Descriptor instead.
+func (*ListEffectiveWeightsRequest) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListEffectiveWeightsRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+type ListEffectiveWeightsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weights []*EffectiveWeights `protobuf:"bytes,1,rep,name=weights,proto3" json:"weights,omitempty"`
+}
+
+func (x *ListEffectiveWeightsResponse) Reset() {
+	*x = ListEffectiveWeightsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEffectiveWeightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEffectiveWeightsResponse) ProtoMessage() {}
+
+func (x *ListEffectiveWeightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEffectiveWeightsResponse.ProtoReflect.Descriptor instead.
+func (*ListEffectiveWeightsResponse) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListEffectiveWeightsResponse) GetWeights() []*EffectiveWeights {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+type PriorityGroupPolicy_Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// higher priority is used first, members not found in any group are put in a group of priority 0
+	Priority uint32 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	// members of these dcs are in group
+	Dcs []string `protobuf:"bytes,2,rep,name=dcs,proto3" json:"dcs,omitempty"`
+	// members with these ips are in group, it takes precedence on dcs
+	Ips []string `protobuf:"bytes,3,rep,name=ips,proto3" json:"ips,omitempty"`
+}
+
+func (x *PriorityGroupPolicy_Group) Reset() {
+	*x = PriorityGroupPolicy_Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriorityGroupPolicy_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriorityGroupPolicy_Group) ProtoMessage() {}
+
+func (x *PriorityGroupPolicy_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_gsloc_services_ops_v1_ops_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriorityGroupPolicy_Group.ProtoReflect.Descriptor instead.
+func (*PriorityGroupPolicy_Group) Descriptor() ([]byte, []int) {
+	return file_gsloc_services_ops_v1_ops_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *PriorityGroupPolicy_Group) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *PriorityGroupPolicy_Group) GetDcs() []string {
+	if x != nil {
+		return x.Dcs
+	}
+	return nil
+}
+
+func (x *PriorityGroupPolicy_Group) GetIps() []string {
+	if x != nil {
+		return x.Ips
+	}
+	return nil
+}
+
+var File_gsloc_services_ops_v1_ops_proto protoreflect.FileDescriptor
+
+var file_gsloc_services_ops_v1_ops_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x6f, 0x70, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x15, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x27, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x32, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x2f, 0x76, 0x31, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x02, 0x0a,
+	0x0d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e,
+	0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f,
+	0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x46, 0x72, 0x6f, 0x6d, 0x22, 0x2b, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07,
+	0x0a, 0x03, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10,
+	0x02, 0x22, 0x38, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x22, 0x60, 0x0a, 0x1a, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67,
+	0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x87, 0x01,
+	0x0a, 0x19, 0x44, 0x69, 0x66, 0x66, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x66,
+	0x71, 0x64, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x12, 0x2c, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x1a, 0x44, 0x69, 0x66, 0x66,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x38, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x34, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x58, 0x0a, 0x14, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x12,
+	0x23, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xea, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x71, 0x64, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x71,
+	0x64, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xa6, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30,
+	0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x71, 0x64, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x54, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x42, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x22, 0x2d, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x71, 0x64, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x71,
+	0x64, 0x6e, 0x73, 0x22, 0x42, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x31, 0x0a,
+	0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a,
+	0x0d, 0x53, 0x4b, 0x49, 0x50, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02,
+	0x22, 0xb7, 0x01, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x73, 0x6c,
+	0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x48, 0x00, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x0a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x97, 0x01, 0x0a, 0x15, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x22, 0x7a, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x55, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70,
+	0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79,
+	0x22, 0xb3, 0x01, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xa6, 0x01, 0x0a, 0x0b, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x12, 0x41, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x67, 0x73, 0x6c,
+	0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x22, 0x2c, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x22,
+	0xa3, 0x01, 0x0a, 0x13, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x0b, 0x46, 0x6c, 0x61, 0x70, 0x44, 0x61,
+	0x6d, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x6c, 0x61,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x6c, 0x61,
+	0x70, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x22, 0x95, 0x02, 0x0a,
+	0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x0a,
+	0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x75, 0x6e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x45, 0x0a, 0x0c, 0x66,
+	0x6c, 0x61, 0x70, 0x5f, 0x64, 0x61, 0x6d, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x70, 0x44, 0x61,
+	0x6d, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x66, 0x6c, 0x61, 0x70, 0x44, 0x61, 0x6d, 0x70, 0x69,
+	0x6e, 0x67, 0x12, 0x38, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x6c,
+	0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x64, 0x63, 0x5f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x44, 0x63, 0x51, 0x75,
+	0x6f, 0x72, 0x75, 0x6d, 0x22, 0xb3, 0x01, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54,
+	0x6c, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
+	0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x69, 0x70,
+	0x68, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x4a, 0x04,
+	0x08, 0x05, 0x10, 0x06, 0x52, 0x14, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73,
+	0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x22, 0xf1, 0x01, 0x0a, 0x14, 0x41,
+	0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x70, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x70, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x28, 0x0a, 0x10, 0x68, 0x69, 0x67, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x73, 0x5f, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x69, 0x67, 0x68, 0x65,
+	0x72, 0x49, 0x73, 0x42, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d,
+	0x69, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61,
+	0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x6d, 0x6f, 0x6f, 0x74,
+	0x68, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12,
+	0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x52, 0x09, 0x73, 0x6d, 0x6f, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0xa5,
+	0x02, 0x0a, 0x13, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x52, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02,
+	0x08, 0x01, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69,
+	0x6e, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x64, 0x52, 0x10, 0x6d,
+	0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x1a,
+	0x55, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x03, 0x64, 0x63, 0x73, 0x12, 0x1e, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x72, 0x02, 0x70,
+	0x01, 0x52, 0x03, 0x69, 0x70, 0x73, 0x22, 0x7a, 0x0a, 0x0f, 0x53, 0x6c, 0x6f, 0x77, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04, 0x08, 0x01, 0x2a, 0x00,
+	0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x28, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x2a, 0x02, 0x18, 0x64, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x14, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x66, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x35, 0x0a, 0x12, 0x69,
+	0x70, 0x76, 0x34, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x20,
+	0x52, 0x10, 0x69, 0x70, 0x76, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x36, 0x0a, 0x12, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x2a, 0x03, 0x18, 0x80, 0x01, 0x52, 0x10, 0x69, 0x70, 0x76, 0x36, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xb1, 0x03, 0x0a, 0x0b, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x04, 0x66, 0x71,
+	0x64, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x12, 0x3b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x12, 0x56, 0x0a, 0x10, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x61, 0x64, 0x61,
+	0x70, 0x74, 0x69, 0x76, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x53, 0x0a, 0x0f,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x12, 0x45, 0x0a, 0x0a, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c,
+	0x6f, 0x77, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x73,
+	0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x54, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x22, 0x5d,
+	0x0a, 0x15, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x34, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x66,
+	0x71, 0x64, 0x6e, 0x22, 0x37, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x22, 0xae, 0x01, 0x0a,
+	0x0b, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x38, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xf3, 0x02,
+	0x0a, 0x11, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x33, 0x0a,
+	0x15, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x10, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x12, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x5f, 0x75, 0x6e,
+	0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x64, 0x63, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x44, 0x63, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x07, 0x63, 0x72, 0x6f, 0x73,
+	0x73, 0x44, 0x63, 0x22, 0x4c, 0x0a, 0x08, 0x44, 0x63, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
+	0x0e, 0x0a, 0x02, 0x64, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x64, 0x63, 0x12,
+	0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x44, 0x63, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x71,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x71, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x12, 0x31, 0x0a, 0x03, 0x64, 0x63, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x63, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x03, 0x64, 0x63, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x0e,
+	0x0a, 0x02, 0x64, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x64, 0x63, 0x12, 0x3a,
+	0x0a, 0x06, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x73, 0x6c, 0x6f,
+	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x4c, 0x0a, 0x1d, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x66,
+	0x71, 0x64, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x66, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x67, 0x73,
+	0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x22, 0x52, 0x0a, 0x0e, 0x44, 0x63, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x0e, 0x44, 0x63, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x64, 0x63, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x63, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x16, 0x54, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x12, 0x59, 0x0a, 0x0b, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x92, 0x01, 0x08, 0x08, 0x01, 0x22, 0x04, 0x72,
+	0x02, 0x70, 0x01, 0x52, 0x03, 0x69, 0x70, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22,
+	0x5d, 0x0a, 0x17, 0x54, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x73,
+	0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x4c,
+	0x0a, 0x14, 0x52, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x66,
+	0x71, 0x64, 0x6e, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x22, 0x80, 0x01, 0x0a,
+	0x15, 0x52, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xf7, 0x02, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x71, 0x64, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x70, 0x12, 0x3f, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x38, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45, 0x56, 0x45,
+	0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0xf9, 0x01, 0x0a, 0x19, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x66, 0x71, 0x64, 0x6e, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x12, 0x4b, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x67,
+	0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10,
+	0x01, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x0a, 0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04, 0x08, 0x01, 0x2a, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x18, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x12, 0x17,
+	0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x70, 0x01, 0x52, 0x02, 0x69, 0x70, 0x22, 0x2e, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x22, 0x5a, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x73, 0x22, 0x4c, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xed, 0x01, 0x0a, 0x10, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x64, 0x63, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x22, 0x31, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x71, 0x64, 0x6e, 0x22, 0x61, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x07,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x32, 0xb3, 0x0f, 0x0a, 0x03, 0x4f, 0x70, 0x73, 0x12,
+	0x79, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x12, 0x44, 0x69,
+	0x66, 0x66, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x30, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2b, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x70, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d,
+	0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f,
+	0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b,
+	0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x73,
+	0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0d, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x67, 0x73, 0x6c,
+	0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x30, 0x01, 0x12, 0x6c, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x12, 0x66, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x29, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x73,
+	0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x56, 0x0a, 0x0e, 0x53, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x2e, 0x67, 0x73,
+	0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x62, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x2c, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x5c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x2e, 0x67, 0x73, 0x6c,
+	0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x85, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x34,
+	0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0f, 0x54,
+	0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x2d,
+	0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f,
+	0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a,
+	0x0d, 0x52, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2b,
+	0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x73,
+	0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12,
+	0x30, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x5c, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x2f, 0x2e, 0x67, 0x73,
+	0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x76, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x2f, 0x2e, 0x67, 0x73, 0x6c, 0x6f,
+	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x67, 0x73, 0x6c,
+	0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x12, 0x32, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x67, 0x73, 0x6c, 0x6f, 0x63,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x47, 0x5a,
+	0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x2d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x72, 0x79, 0x2f,
+	0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x73, 0x6c, 0x6f, 0x63, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6f, 0x70, 0x73, 0x2f, 0x76, 0x31, 0x3b,
+	0x6f, 0x70, 0x73, 0x73, 0x76, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gsloc_services_ops_v1_ops_proto_rawDescOnce sync.Once
+	file_gsloc_services_ops_v1_ops_proto_rawDescData = file_gsloc_services_ops_v1_ops_proto_rawDesc
+)
+
+func file_gsloc_services_ops_v1_ops_proto_rawDescGZIP() []byte {
+	file_gsloc_services_ops_v1_ops_proto_rawDescOnce.Do(func() {
+		file_gsloc_services_ops_v1_ops_proto_rawDescData = protoimpl.X.CompressGZIP(file_gsloc_services_ops_v1_ops_proto_rawDescData)
+	})
+	return file_gsloc_services_ops_v1_ops_proto_rawDescData
+}
+
+var file_gsloc_services_ops_v1_ops_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_gsloc_services_ops_v1_ops_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
+var file_gsloc_services_ops_v1_ops_proto_goTypes = []interface{}{
+	(EntryRevision_Action)(0),              // 0: gsloc.services.ops.v1.EntryRevision.Action
+	(ImportOptions_Mode)(0),                // 1: gsloc.services.ops.v1.ImportOptions.Mode
+	(EntryChange_Action)(0),                // 2: gsloc.services.ops.v1.EntryChange.Action
+	(MemberSignal_Level)(0),                // 3: gsloc.services.ops.v1.MemberSignal.Level
+	(*EntryRevision)(nil),                  // 4: gsloc.services.ops.v1.EntryRevision
+	(*ListEntryRevisionsRequest)(nil),      // 5: gsloc.services.ops.v1.ListEntryRevisionsRequest
+	(*ListEntryRevisionsResponse)(nil),     // 6: gsloc.services.ops.v1.ListEntryRevisionsResponse
+	(*DiffEntryRevisionsRequest)(nil),      // 7: gsloc.services.ops.v1.DiffEntryRevisionsRequest
+	(*DiffEntryRevisionsResponse)(nil),     // 8: gsloc.services.ops.v1.DiffEntryRevisionsResponse
+	(*RollbackEntryRequest)(nil),           // 9: gsloc.services.ops.v1.RollbackEntryRequest
+	(*AuditEvent)(nil),                     // 10: gsloc.services.ops.v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),         // 11: gsloc.services.ops.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),        // 12: gsloc.services.ops.v1.ListAuditEventsResponse
+	(*ResignEntriesRequest)(nil),           // 13: gsloc.services.ops.v1.ResignEntriesRequest
+	(*ResignEntriesResponse)(nil),          // 14: gsloc.services.ops.v1.ResignEntriesResponse
+	(*ExportEntriesRequest)(nil),           // 15: gsloc.services.ops.v1.ExportEntriesRequest
+	(*ImportOptions)(nil),                  // 16: gsloc.services.ops.v1.ImportOptions
+	(*ImportEntriesRequest)(nil),           // 17: gsloc.services.ops.v1.ImportEntriesRequest
+	(*ImportEntriesResponse)(nil),          // 18: gsloc.services.ops.v1.ImportEntriesResponse
+	(*PlanOptions)(nil),                    // 19: gsloc.services.ops.v1.PlanOptions
+	(*PlanEntriesRequest)(nil),             // 20: gsloc.services.ops.v1.PlanEntriesRequest
+	(*EntryChange)(nil),                    // 21: gsloc.services.ops.v1.EntryChange
+	(*PlanEntriesResponse)(nil),            // 22: gsloc.services.ops.v1.PlanEntriesResponse
+	(*FlapDamping)(nil),                    // 23: gsloc.services.ops.v1.FlapDamping
+	(*HealthPolicy)(nil),                   // 24: gsloc.services.ops.v1.HealthPolicy
+	(*HealthTlsPolicy)(nil),                // 25: gsloc.services.ops.v1.HealthTlsPolicy
+	(*AdaptiveWeightPolicy)(nil),           // 26: gsloc.services.ops.v1.AdaptiveWeightPolicy
+	(*PriorityGroupPolicy)(nil),            // 27: gsloc.services.ops.v1.PriorityGroupPolicy
+	(*SlowStartPolicy)(nil),                // 28: gsloc.services.ops.v1.SlowStartPolicy
+	(*ClientAffinityPolicy)(nil),           // 29: gsloc.services.ops.v1.ClientAffinityPolicy
+	(*EntryPolicy)(nil),                    // 30: gsloc.services.ops.v1.EntryPolicy
+	(*SetEntryPolicyRequest)(nil),          // 31: gsloc.services.ops.v1.SetEntryPolicyRequest
+	(*GetEntryPolicyRequest)(nil),          // 32: gsloc.services.ops.v1.GetEntryPolicyRequest
+	(*DeleteEntryPolicyRequest)(nil),       // 33: gsloc.services.ops.v1.DeleteEntryPolicyRequest
+	(*ProbeRecord)(nil),                    // 34: gsloc.services.ops.v1.ProbeRecord
+	(*MemberHealthState)(nil),              // 35: gsloc.services.ops.v1.MemberHealthState
+	(*DcHealth)(nil),                       // 36: gsloc.services.ops.v1.DcHealth
+	(*CrossDcHealth)(nil),                  // 37: gsloc.services.ops.v1.CrossDcHealth
+	(*MemberHealthHistory)(nil),            // 38: gsloc.services.ops.v1.MemberHealthHistory
+	(*GetMemberHealthHistoryRequest)(nil),  // 39: gsloc.services.ops.v1.GetMemberHealthHistoryRequest
+	(*GetMemberHealthHistoryResponse)(nil), // 40: gsloc.services.ops.v1.GetMemberHealthHistoryResponse
+	(*DcMemberHealth)(nil),                 // 41: gsloc.services.ops.v1.DcMemberHealth
+	(*DcHealthReport)(nil),                 // 42: gsloc.services.ops.v1.DcHealthReport
+	(*TestHealthCheckRequest)(nil),         // 43: gsloc.services.ops.v1.TestHealthCheckRequest
+	(*MemberCheckResult)(nil),              // 44: gsloc.services.ops.v1.MemberCheckResult
+	(*TestHealthCheckResponse)(nil),        // 45: gsloc.services.ops.v1.TestHealthCheckResponse
+	(*RecheckMemberRequest)(nil),           // 46: gsloc.services.ops.v1.RecheckMemberRequest
+	(*RecheckMemberResponse)(nil),          // 47: gsloc.services.ops.v1.RecheckMemberResponse
+	(*MemberSignal)(nil),                   // 48: gsloc.services.ops.v1.MemberSignal
+	(*ReportMemberSignalRequest)(nil),      // 49: gsloc.services.ops.v1.ReportMemberSignalRequest
+	(*ClearMemberSignalRequest)(nil),       // 50: gsloc.services.ops.v1.ClearMemberSignalRequest
+	(*ListMemberSignalsRequest)(nil),       // 51: gsloc.services.ops.v1.ListMemberSignalsRequest
+	(*ListMemberSignalsResponse)(nil),      // 52: gsloc.services.ops.v1.ListMemberSignalsResponse
+	(*MemberWeight)(nil),                   // 53: gsloc.services.ops.v1.MemberWeight
+	(*EffectiveWeights)(nil),               // 54: gsloc.services.ops.v1.EffectiveWeights
+	(*ListEffectiveWeightsRequest)(nil),    // 55: gsloc.services.ops.v1.ListEffectiveWeightsRequest
+	(*ListEffectiveWeightsResponse)(nil),   // 56: gsloc.services.ops.v1.ListEffectiveWeightsResponse
+	(*PriorityGroupPolicy_Group)(nil),      // 57: gsloc.services.ops.v1.PriorityGroupPolicy.Group
+	(*timestamppb.Timestamp)(nil),          // 58: google.protobuf.Timestamp
+	(*v1.SignedEntry)(nil),                 // 59: gsloc.api.config.entries.v1.SignedEntry
+	(*durationpb.Duration)(nil),            // 60: google.protobuf.Duration
+	(*v11.HealthCheck)(nil),                // 61: gsloc.api.config.healthchecks.v1.HealthCheck
+	(*emptypb.Empty)(nil),                  // 62: google.protobuf.Empty
+}
+var file_gsloc_services_ops_v1_ops_proto_depIdxs = []int32{
+	58, // 0: gsloc.services.ops.v1.EntryRevision.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: gsloc.services.ops.v1.EntryRevision.action:type_name -> gsloc.services.ops.v1.EntryRevision.Action
+	59, // 2: gsloc.services.ops.v1.EntryRevision.signed_entry:type_name -> gsloc.api.config.entries.v1.SignedEntry
+	4,  // 3: gsloc.services.ops.v1.ListEntryRevisionsResponse.revisions:type_name -> gsloc.services.ops.v1.EntryRevision
+	4,  // 4: gsloc.services.ops.v1.DiffEntryRevisionsResponse.from:type_name -> gsloc.services.ops.v1.EntryRevision
+	4,  // 5: gsloc.services.ops.v1.DiffEntryRevisionsResponse.to:type_name -> gsloc.services.ops.v1.EntryRevision
+	58, // 6: gsloc.services.ops.v1.AuditEvent.timestamp:type_name -> google.protobuf.Timestamp
+	58, // 7: gsloc.services.ops.v1.ListAuditEventsRequest.since:type_name -> google.protobuf.Timestamp
+	58, // 8: gsloc.services.ops.v1.ListAuditEventsRequest.until:type_name -> google.protobuf.Timestamp
+	10, // 9: gsloc.services.ops.v1.ListAuditEventsResponse.events:type_name -> gsloc.services.ops.v1.AuditEvent
+	1,  // 10: gsloc.services.ops.v1.ImportOptions.mode:type_name -> gsloc.services.ops.v1.ImportOptions.Mode
+	16, // 11: gsloc.services.ops.v1.ImportEntriesRequest.options:type_name -> gsloc.services.ops.v1.ImportOptions
+	59, // 12: gsloc.services.ops.v1.ImportEntriesRequest.signed_entry:type_name -> gsloc.api.config.entries.v1.SignedEntry
+	19, // 13: gsloc.services.ops.v1.PlanEntriesRequest.options:type_name -> gsloc.services.ops.v1.PlanOptions
+	59, // 14: gsloc.services.ops.v1.PlanEntriesRequest.signed_entry:type_name -> gsloc.api.config.entries.v1.SignedEntry
+	2,  // 15: gsloc.services.ops.v1.EntryChange.action:type_name -> gsloc.services.ops.v1.EntryChange.Action
+	21, // 16: gsloc.services.ops.v1.PlanEntriesResponse.changes:type_name -> gsloc.services.ops.v1.EntryChange
+	60, // 17: gsloc.services.ops.v1.FlapDamping.window:type_name -> google.protobuf.Duration
+	60, // 18: gsloc.services.ops.v1.FlapDamping.suppress:type_name -> google.protobuf.Duration
+	23, // 19: gsloc.services.ops.v1.HealthPolicy.flap_damping:type_name -> gsloc.services.ops.v1.FlapDamping
+	25, // 20: gsloc.services.ops.v1.HealthPolicy.tls:type_name -> gsloc.services.ops.v1.HealthTlsPolicy
+	57, // 21: gsloc.services.ops.v1.PriorityGroupPolicy.groups:type_name -> gsloc.services.ops.v1.PriorityGroupPolicy.Group
+	60, // 22: gsloc.services.ops.v1.SlowStartPolicy.window:type_name -> google.protobuf.Duration
+	24, // 23: gsloc.services.ops.v1.EntryPolicy.health:type_name -> gsloc.services.ops.v1.HealthPolicy
+	26, // 24: gsloc.services.ops.v1.EntryPolicy.adaptive_weights:type_name -> gsloc.services.ops.v1.AdaptiveWeightPolicy
+	27, // 25: gsloc.services.ops.v1.EntryPolicy.priority_groups:type_name -> gsloc.services.ops.v1.PriorityGroupPolicy
+	28, // 26: gsloc.services.ops.v1.EntryPolicy.slow_start:type_name -> gsloc.services.ops.v1.SlowStartPolicy
+	29, // 27: gsloc.services.ops.v1.EntryPolicy.client_affinity:type_name -> gsloc.services.ops.v1.ClientAffinityPolicy
+	30, // 28: gsloc.services.ops.v1.SetEntryPolicyRequest.policy:type_name -> gsloc.services.ops.v1.EntryPolicy
+	58, // 29: gsloc.services.ops.v1.ProbeRecord.timestamp:type_name -> google.protobuf.Timestamp
+	60, // 30: gsloc.services.ops.v1.ProbeRecord.duration:type_name -> google.protobuf.Duration
+	58, // 31: gsloc.services.ops.v1.MemberHealthState.damped_until:type_name -> google.protobuf.Timestamp
+	37, // 32: gsloc.services.ops.v1.MemberHealthState.cross_dc:type_name -> gsloc.services.ops.v1.CrossDcHealth
+	36, // 33: gsloc.services.ops.v1.CrossDcHealth.dcs:type_name -> gsloc.services.ops.v1.DcHealth
+	34, // 34: gsloc.services.ops.v1.MemberHealthHistory.probes:type_name -> gsloc.services.ops.v1.ProbeRecord
+	35, // 35: gsloc.services.ops.v1.MemberHealthHistory.state:type_name -> gsloc.services.ops.v1.MemberHealthState
+	38, // 36: gsloc.services.ops.v1.GetMemberHealthHistoryResponse.members:type_name -> gsloc.services.ops.v1.MemberHealthHistory
+	58, // 37: gsloc.services.ops.v1.DcHealthReport.expires_at:type_name -> google.protobuf.Timestamp
+	41, // 38: gsloc.services.ops.v1.DcHealthReport.members:type_name -> gsloc.services.ops.v1.DcMemberHealth
+	61, // 39: gsloc.services.ops.v1.TestHealthCheckRequest.healthcheck:type_name -> gsloc.api.config.healthchecks.v1.HealthCheck
+	60, // 40: gsloc.services.ops.v1.MemberCheckResult.duration:type_name -> google.protobuf.Duration
+	44, // 41: gsloc.services.ops.v1.TestHealthCheckResponse.results:type_name -> gsloc.services.ops.v1.MemberCheckResult
+	60, // 42: gsloc.services.ops.v1.RecheckMemberResponse.duration:type_name -> google.protobuf.Duration
+	3,  // 43: gsloc.services.ops.v1.MemberSignal.level:type_name -> gsloc.services.ops.v1.MemberSignal.Level
+	58, // 44: gsloc.services.ops.v1.MemberSignal.reported_at:type_name -> google.protobuf.Timestamp
+	58, // 45: gsloc.services.ops.v1.MemberSignal.expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 46: gsloc.services.ops.v1.ReportMemberSignalRequest.level:type_name -> gsloc.services.ops.v1.MemberSignal.Level
+	60, // 47: gsloc.services.ops.v1.ReportMemberSignalRequest.duration:type_name -> google.protobuf.Duration
+	48, // 48: gsloc.services.ops.v1.ListMemberSignalsResponse.signals:type_name -> gsloc.services.ops.v1.MemberSignal
+	58, // 49: gsloc.services.ops.v1.EffectiveWeights.computed_at:type_name -> google.protobuf.Timestamp
+	58, // 50: gsloc.services.ops.v1.EffectiveWeights.expires_at:type_name -> google.protobuf.Timestamp
+	53, // 51: gsloc.services.ops.v1.EffectiveWeights.members:type_name -> gsloc.services.ops.v1.MemberWeight
+	54, // 52: gsloc.services.ops.v1.ListEffectiveWeightsResponse.weights:type_name -> gsloc.services.ops.v1.EffectiveWeights
+	5,  // 53: gsloc.services.ops.v1.Ops.ListEntryRevisions:input_type -> gsloc.services.ops.v1.ListEntryRevisionsRequest
+	7,  // 54: gsloc.services.ops.v1.Ops.DiffEntryRevisions:input_type -> gsloc.services.ops.v1.DiffEntryRevisionsRequest
+	9,  // 55: gsloc.services.ops.v1.Ops.RollbackEntry:input_type -> gsloc.services.ops.v1.RollbackEntryRequest
+	11, // 56: gsloc.services.ops.v1.Ops.ListAuditEvents:input_type -> gsloc.services.ops.v1.ListAuditEventsRequest
+	13, // 57: gsloc.services.ops.v1.Ops.ResignEntries:input_type -> gsloc.services.ops.v1.ResignEntriesRequest
+	15, // 58: gsloc.services.ops.v1.Ops.ExportEntries:input_type -> gsloc.services.ops.v1.ExportEntriesRequest
+	17, // 59: gsloc.services.ops.v1.Ops.ImportEntries:input_type -> gsloc.services.ops.v1.ImportEntriesRequest
+	20, // 60: gsloc.services.ops.v1.Ops.PlanEntries:input_type -> gsloc.services.ops.v1.PlanEntriesRequest
+	31, // 61: gsloc.services.ops.v1.Ops.SetEntryPolicy:input_type -> gsloc.services.ops.v1.SetEntryPolicyRequest
+	32, // 62: gsloc.services.ops.v1.Ops.GetEntryPolicy:input_type -> gsloc.services.ops.v1.GetEntryPolicyRequest
+	33, // 63: gsloc.services.ops.v1.Ops.DeleteEntryPolicy:input_type -> gsloc.services.ops.v1.DeleteEntryPolicyRequest
+	39, // 64: gsloc.services.ops.v1.Ops.GetMemberHealthHistory:input_type -> gsloc.services.ops.v1.GetMemberHealthHistoryRequest
+	43, // 65: gsloc.services.ops.v1.Ops.TestHealthCheck:input_type -> gsloc.services.ops.v1.TestHealthCheckRequest
+	46, // 66: gsloc.services.ops.v1.Ops.RecheckMember:input_type -> gsloc.services.ops.v1.RecheckMemberRequest
+	49, // 67: gsloc.services.ops.v1.Ops.ReportMemberSignal:input_type -> gsloc.services.ops.v1.ReportMemberSignalRequest
+	50, // 68: gsloc.services.ops.v1.Ops.ClearMemberSignal:input_type -> gsloc.services.ops.v1.ClearMemberSignalRequest
+	51, // 69: gsloc.services.ops.v1.Ops.ListMemberSignals:input_type -> gsloc.services.ops.v1.ListMemberSignalsRequest
+	55, // 70: gsloc.services.ops.v1.Ops.ListEffectiveWeights:input_type -> gsloc.services.ops.v1.ListEffectiveWeightsRequest
+	6,  // 71: gsloc.services.ops.v1.Ops.ListEntryRevisions:output_type -> gsloc.services.ops.v1.ListEntryRevisionsResponse
+	8,  // 72: gsloc.services.ops.v1.Ops.DiffEntryRevisions:output_type -> gsloc.services.ops.v1.DiffEntryRevisionsResponse
+	62, // 73: gsloc.services.ops.v1.Ops.RollbackEntry:output_type -> google.protobuf.Empty
+	12, // 74: gsloc.services.ops.v1.Ops.ListAuditEvents:output_type -> gsloc.services.ops.v1.ListAuditEventsResponse
+	14, // 75: gsloc.services.ops.v1.Ops.ResignEntries:output_type -> gsloc.services.ops.v1.ResignEntriesResponse
+	59, // 76: gsloc.services.ops.v1.Ops.ExportEntries:output_type -> gsloc.api.config.entries.v1.SignedEntry
+	18, // 77: gsloc.services.ops.v1.Ops.ImportEntries:output_type -> gsloc.services.ops.v1.ImportEntriesResponse
+	22, // 78: gsloc.services.ops.v1.Ops.PlanEntries:output_type -> gsloc.services.ops.v1.PlanEntriesResponse
+	62, // 79: gsloc.services.ops.v1.Ops.SetEntryPolicy:output_type -> google.protobuf.Empty
+	30, // 80: gsloc.services.ops.v1.Ops.GetEntryPolicy:output_type -> gsloc.services.ops.v1.EntryPolicy
+	62, // 81: gsloc.services.ops.v1.Ops.DeleteEntryPolicy:output_type -> google.protobuf.Empty
+	40, // 82: gsloc.services.ops.v1.Ops.GetMemberHealthHistory:output_type -> gsloc.services.ops.v1.GetMemberHealthHistoryResponse
+	45, // 83: gsloc.services.ops.v1.Ops.TestHealthCheck:output_type -> gsloc.services.ops.v1.TestHealthCheckResponse
+	47, // 84: gsloc.services.ops.v1.Ops.RecheckMember:output_type -> gsloc.services.ops.v1.RecheckMemberResponse
+	48, // 85: gsloc.services.ops.v1.Ops.ReportMemberSignal:output_type -> gsloc.services.ops.v1.MemberSignal
+	62, // 86: gsloc.services.ops.v1.Ops.ClearMemberSignal:output_type -> google.protobuf.Empty
+	52, // 87: gsloc.services.ops.v1.Ops.ListMemberSignals:output_type -> gsloc.services.ops.v1.ListMemberSignalsResponse
+	56, // 88: gsloc.services.ops.v1.Ops.ListEffectiveWeights:output_type -> gsloc.services.ops.v1.ListEffectiveWeightsResponse
+	71, // [71:89] is the sub-list for method output_type
+	53, // [53:71] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
+}
+
+func init() { file_gsloc_services_ops_v1_ops_proto_init() }
+func file_gsloc_services_ops_v1_ops_proto_init() {
+	if File_gsloc_services_ops_v1_ops_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntryRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEntryRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEntryRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffEntryRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffEntryRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResignEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResignEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntryChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlapDamping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthTlsPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdaptiveWeightPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriorityGroupPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlowStartPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientAffinityPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntryPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEntryPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntryPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEntryPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberHealthState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DcHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrossDcHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberHealthHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMemberHealthHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMemberHealthHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DcMemberHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DcHealthReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestHealthCheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberCheckResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestHealthCheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecheckMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecheckMemberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberSignal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportMemberSignalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearMemberSignalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMemberSignalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMemberSignalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberWeight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EffectiveWeights); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEffectiveWeightsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEffectiveWeightsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gsloc_services_ops_v1_ops_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriorityGroupPolicy_Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_gsloc_services_ops_v1_ops_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*ImportEntriesRequest_Options)(nil),
+		(*ImportEntriesRequest_SignedEntry)(nil),
+	}
+	file_gsloc_services_ops_v1_ops_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*PlanEntriesRequest_Options)(nil),
+		(*PlanEntriesRequest_SignedEntry)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gsloc_services_ops_v1_ops_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   54,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gsloc_services_ops_v1_ops_proto_goTypes,
+		DependencyIndexes: file_gsloc_services_ops_v1_ops_proto_depIdxs,
+		EnumInfos:         file_gsloc_services_ops_v1_ops_proto_enumTypes,
+		MessageInfos:      file_gsloc_services_ops_v1_ops_proto_msgTypes,
+	}.Build()
+	File_gsloc_services_ops_v1_ops_proto = out.File
+	file_gsloc_services_ops_v1_ops_proto_rawDesc = nil
+	file_gsloc_services_ops_v1_ops_proto_goTypes = nil
+	file_gsloc_services_ops_v1_ops_proto_depIdxs = nil
+}
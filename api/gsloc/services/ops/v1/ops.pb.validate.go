@@ -0,0 +1,7562 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: gsloc/services/ops/v1/ops.proto
+
+package opssvc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on EntryRevision with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EntryRevision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EntryRevision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EntryRevisionMultiError, or
+// nil if none found.
+func (m *EntryRevision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EntryRevision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Revision
+
+	// no validation rules for Author
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntryRevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntryRevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntryRevisionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Action
+
+	if all {
+		switch v := interface{}(m.GetSignedEntry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntryRevisionValidationError{
+					field:  "SignedEntry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntryRevisionValidationError{
+					field:  "SignedEntry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSignedEntry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntryRevisionValidationError{
+				field:  "SignedEntry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RollbackFrom
+
+	if len(errors) > 0 {
+		return EntryRevisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// EntryRevisionMultiError is an error wrapping multiple validation errors
+// returned by EntryRevision.ValidateAll() if the designated constraints
+// aren't met.
+type EntryRevisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EntryRevisionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EntryRevisionMultiError) AllErrors() []error { return m }
+
+// EntryRevisionValidationError is the validation error returned by
+// EntryRevision.Validate if the designated constraints aren't met.
+type EntryRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EntryRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EntryRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EntryRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EntryRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EntryRevisionValidationError) ErrorName() string { return "EntryRevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EntryRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEntryRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EntryRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EntryRevisionValidationError{}
+
+// Validate checks the field values on ListEntryRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEntryRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEntryRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEntryRevisionsRequestMultiError, or nil if none found.
+func (m *ListEntryRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEntryRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFqdn()) < 1 {
+		err := ListEntryRevisionsRequestValidationError{
+			field:  "Fqdn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListEntryRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEntryRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListEntryRevisionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListEntryRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEntryRevisionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEntryRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListEntryRevisionsRequestValidationError is the validation error returned by
+// ListEntryRevisionsRequest.Validate if the designated constraints aren't met.
+type ListEntryRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEntryRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEntryRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEntryRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEntryRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEntryRevisionsRequestValidationError) ErrorName() string {
+	return "ListEntryRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEntryRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEntryRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEntryRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEntryRevisionsRequestValidationError{}
+
+// Validate checks the field values on ListEntryRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEntryRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEntryRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEntryRevisionsResponseMultiError, or nil if none found.
+func (m *ListEntryRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEntryRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEntryRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEntryRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEntryRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListEntryRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEntryRevisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListEntryRevisionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListEntryRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEntryRevisionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEntryRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// ListEntryRevisionsResponseValidationError is the validation error returned
+// by ListEntryRevisionsResponse.Validate if the designated constraints aren't met.
+type ListEntryRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEntryRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEntryRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEntryRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEntryRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEntryRevisionsResponseValidationError) ErrorName() string {
+	return "ListEntryRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEntryRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEntryRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEntryRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEntryRevisionsResponseValidationError{}
+
+// Validate checks the field values on DiffEntryRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffEntryRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffEntryRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffEntryRevisionsRequestMultiError, or nil if none found.
+func (m *DiffEntryRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffEntryRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFqdn()) < 1 {
+		err := DiffEntryRevisionsRequestValidationError{
+			field:  "Fqdn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFromRevision() <= 0 {
+		err := DiffEntryRevisionsRequestValidationError{
+			field:  "FromRevision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ToRevision
+
+	if len(errors) > 0 {
+		return DiffEntryRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffEntryRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by DiffEntryRevisionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DiffEntryRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffEntryRevisionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffEntryRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// DiffEntryRevisionsRequestValidationError is the validation error returned by
+// DiffEntryRevisionsRequest.Validate if the designated constraints aren't met.
+type DiffEntryRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffEntryRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffEntryRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffEntryRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffEntryRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffEntryRevisionsRequestValidationError) ErrorName() string {
+	return "DiffEntryRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffEntryRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffEntryRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffEntryRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffEntryRevisionsRequestValidationError{}
+
+// Validate checks the field values on DiffEntryRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffEntryRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffEntryRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffEntryRevisionsResponseMultiError, or nil if none found.
+func (m *DiffEntryRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffEntryRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Diff
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffEntryRevisionsResponseValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffEntryRevisionsResponseValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffEntryRevisionsResponseValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffEntryRevisionsResponseValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffEntryRevisionsResponseValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffEntryRevisionsResponseValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiffEntryRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffEntryRevisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by DiffEntryRevisionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DiffEntryRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffEntryRevisionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffEntryRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// DiffEntryRevisionsResponseValidationError is the validation error returned
+// by DiffEntryRevisionsResponse.Validate if the designated constraints aren't met.
+type DiffEntryRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffEntryRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffEntryRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffEntryRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffEntryRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffEntryRevisionsResponseValidationError) ErrorName() string {
+	return "DiffEntryRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffEntryRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffEntryRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffEntryRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffEntryRevisionsResponseValidationError{}
+
+// Validate checks the field values on RollbackEntryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackEntryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackEntryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackEntryRequestMultiError, or nil if none found.
+func (m *RollbackEntryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackEntryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFqdn()) < 1 {
+		err := RollbackEntryRequestValidationError{
+			field:  "Fqdn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRevision() <= 0 {
+		err := RollbackEntryRequestValidationError{
+			field:  "Revision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RollbackEntryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackEntryRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackEntryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackEntryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackEntryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackEntryRequestMultiError) AllErrors() []error { return m }
+
+// RollbackEntryRequestValidationError is the validation error returned by
+// RollbackEntryRequest.Validate if the designated constraints aren't met.
+type RollbackEntryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackEntryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackEntryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackEntryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackEntryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackEntryRequestValidationError) ErrorName() string {
+	return "RollbackEntryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackEntryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackEntryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackEntryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackEntryRequestValidationError{}
+
+// Validate checks the field values on AuditEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Caller
+
+	// no validation rules for Method
+
+	// no validation rules for Diff
+
+	// no validation rules for Success
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return AuditEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEventMultiError) AllErrors() []error { return m }
+
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEventValidationError{}
+
+// Validate checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Fqdn
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListAuditEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsRequestValidationError{}
+
+// Validate checks the field values on ListAuditEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsResponseMultiError, or nil if none found.
+func (m *ListAuditEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAuditEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsResponseValidationError) ErrorName() string {
+	return "ListAuditEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsResponseValidationError{}
+
+// Validate checks the field values on ResignEntriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResignEntriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResignEntriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResignEntriesRequestMultiError, or nil if none found.
+func (m *ResignEntriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResignEntriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Prefix
+
+	if len(errors) > 0 {
+		return ResignEntriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResignEntriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ResignEntriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResignEntriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResignEntriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResignEntriesRequestMultiError) AllErrors() []error { return m }
+
+// ResignEntriesRequestValidationError is the validation error returned by
+// ResignEntriesRequest.Validate if the designated constraints aren't met.
+type ResignEntriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResignEntriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResignEntriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResignEntriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResignEntriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResignEntriesRequestValidationError) ErrorName() string {
+	return "ResignEntriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResignEntriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResignEntriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResignEntriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResignEntriesRequestValidationError{}
+
+// Validate checks the field values on ResignEntriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResignEntriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResignEntriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResignEntriesResponseMultiError, or nil if none found.
+func (m *ResignEntriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResignEntriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResignEntriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResignEntriesResponseMultiError is an error wrapping multiple validation
+// errors returned by ResignEntriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResignEntriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResignEntriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResignEntriesResponseMultiError) AllErrors() []error { return m }
+
+// ResignEntriesResponseValidationError is the validation error returned by
+// ResignEntriesResponse.Validate if the designated constraints aren't met.
+type ResignEntriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResignEntriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResignEntriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResignEntriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResignEntriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResignEntriesResponseValidationError) ErrorName() string {
+	return "ResignEntriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResignEntriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResignEntriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResignEntriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResignEntriesResponseValidationError{}
+
+// Validate checks the field values on ExportEntriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportEntriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportEntriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportEntriesRequestMultiError, or nil if none found.
+func (m *ExportEntriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportEntriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Prefix
+
+	if len(errors) > 0 {
+		return ExportEntriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportEntriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportEntriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportEntriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportEntriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportEntriesRequestMultiError) AllErrors() []error { return m }
+
+// ExportEntriesRequestValidationError is the validation error returned by
+// ExportEntriesRequest.Validate if the designated constraints aren't met.
+type ExportEntriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportEntriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportEntriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportEntriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportEntriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportEntriesRequestValidationError) ErrorName() string {
+	return "ExportEntriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportEntriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportEntriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportEntriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportEntriesRequestValidationError{}
+
+// Validate checks the field values on ImportOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportOptionsMultiError, or
+// nil if none found.
+func (m *ImportOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Mode
+
+	// no validation rules for Prefix
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ImportOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportOptionsMultiError is an error wrapping multiple validation errors
+// returned by ImportOptions.ValidateAll() if the designated constraints
+// aren't met.
+type ImportOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportOptionsMultiError) AllErrors() []error { return m }
+
+// ImportOptionsValidationError is the validation error returned by
+// ImportOptions.Validate if the designated constraints aren't met.
+type ImportOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportOptionsValidationError) ErrorName() string { return "ImportOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportOptionsValidationError{}
+
+// Validate checks the field values on ImportEntriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportEntriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportEntriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportEntriesRequestMultiError, or nil if none found.
+func (m *ImportEntriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportEntriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofRequestPresent := false
+	switch v := m.Request.(type) {
+	case *ImportEntriesRequest_Options:
+		if v == nil {
+			err := ImportEntriesRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		if all {
+			switch v := interface{}(m.GetOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportEntriesRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportEntriesRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportEntriesRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ImportEntriesRequest_SignedEntry:
+		if v == nil {
+			err := ImportEntriesRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		if all {
+			switch v := interface{}(m.GetSignedEntry()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportEntriesRequestValidationError{
+						field:  "SignedEntry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportEntriesRequestValidationError{
+						field:  "SignedEntry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSignedEntry()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportEntriesRequestValidationError{
+					field:  "SignedEntry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofRequestPresent {
+		err := ImportEntriesRequestValidationError{
+			field:  "Request",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImportEntriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportEntriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportEntriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportEntriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportEntriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportEntriesRequestMultiError) AllErrors() []error { return m }
+
+// ImportEntriesRequestValidationError is the validation error returned by
+// ImportEntriesRequest.Validate if the designated constraints aren't met.
+type ImportEntriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportEntriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportEntriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportEntriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportEntriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportEntriesRequestValidationError) ErrorName() string {
+	return "ImportEntriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportEntriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportEntriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportEntriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportEntriesRequestValidationError{}
+
+// Validate checks the field values on ImportEntriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportEntriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportEntriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportEntriesResponseMultiError, or nil if none found.
+func (m *ImportEntriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportEntriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ImportEntriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportEntriesResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportEntriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportEntriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportEntriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportEntriesResponseMultiError) AllErrors() []error { return m }
+
+// ImportEntriesResponseValidationError is the validation error returned by
+// ImportEntriesResponse.Validate if the designated constraints aren't met.
+type ImportEntriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportEntriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportEntriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportEntriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportEntriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportEntriesResponseValidationError) ErrorName() string {
+	return "ImportEntriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportEntriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportEntriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportEntriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportEntriesResponseValidationError{}
+
+// Validate checks the field values on PlanOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PlanOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PlanOptionsMultiError, or
+// nil if none found.
+func (m *PlanOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Prefix
+
+	// no validation rules for DeleteUnmanaged
+
+	// no validation rules for Apply
+
+	if len(errors) > 0 {
+		return PlanOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanOptionsMultiError is an error wrapping multiple validation errors
+// returned by PlanOptions.ValidateAll() if the designated constraints aren't met.
+type PlanOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanOptionsMultiError) AllErrors() []error { return m }
+
+// PlanOptionsValidationError is the validation error returned by
+// PlanOptions.Validate if the designated constraints aren't met.
+type PlanOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanOptionsValidationError) ErrorName() string { return "PlanOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlanOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanOptionsValidationError{}
+
+// Validate checks the field values on PlanEntriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlanEntriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanEntriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanEntriesRequestMultiError, or nil if none found.
+func (m *PlanEntriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanEntriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofRequestPresent := false
+	switch v := m.Request.(type) {
+	case *PlanEntriesRequest_Options:
+		if v == nil {
+			err := PlanEntriesRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		if all {
+			switch v := interface{}(m.GetOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanEntriesRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanEntriesRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanEntriesRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *PlanEntriesRequest_SignedEntry:
+		if v == nil {
+			err := PlanEntriesRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		if all {
+			switch v := interface{}(m.GetSignedEntry()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanEntriesRequestValidationError{
+						field:  "SignedEntry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanEntriesRequestValidationError{
+						field:  "SignedEntry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSignedEntry()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanEntriesRequestValidationError{
+					field:  "SignedEntry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofRequestPresent {
+		err := PlanEntriesRequestValidationError{
+			field:  "Request",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PlanEntriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanEntriesRequestMultiError is an error wrapping multiple validation errors
+// returned by PlanEntriesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PlanEntriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanEntriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanEntriesRequestMultiError) AllErrors() []error { return m }
+
+// PlanEntriesRequestValidationError is the validation error returned by
+// PlanEntriesRequest.Validate if the designated constraints aren't met.
+type PlanEntriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanEntriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanEntriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanEntriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanEntriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanEntriesRequestValidationError) ErrorName() string {
+	return "PlanEntriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanEntriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanEntriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanEntriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanEntriesRequestValidationError{}
+
+// Validate checks the field values on EntryChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EntryChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EntryChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EntryChangeMultiError, or
+// nil if none found.
+func (m *EntryChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EntryChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fqdn
+
+	// no validation rules for Action
+
+	// no validation rules for Diff
+
+	if len(errors) > 0 {
+		return EntryChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// EntryChangeMultiError is an error wrapping multiple validation errors
+// returned by EntryChange.ValidateAll() if the designated constraints aren't met.
+type EntryChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EntryChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EntryChangeMultiError) AllErrors() []error { return m }
+
+// EntryChangeValidationError is the validation error returned by
+// EntryChange.Validate if the designated constraints aren't met.
+type EntryChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EntryChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EntryChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EntryChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EntryChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EntryChangeValidationError) ErrorName() string { return "EntryChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EntryChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEntryChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EntryChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EntryChangeValidationError{}
+
+// Validate checks the field values on PlanEntriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlanEntriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanEntriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanEntriesResponseMultiError, or nil if none found.
+func (m *PlanEntriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanEntriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanEntriesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanEntriesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanEntriesResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Applied
+
+	if len(errors) > 0 {
+		return PlanEntriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanEntriesResponseMultiError is an error wrapping multiple validation
+// errors returned by PlanEntriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PlanEntriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanEntriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanEntriesResponseMultiError) AllErrors() []error { return m }
+
+// PlanEntriesResponseValidationError is the validation error returned by
+// PlanEntriesResponse.Validate if the designated constraints aren't met.
+type PlanEntriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanEntriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanEntriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanEntriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanEntriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanEntriesResponseValidationError) ErrorName() string {
+	return "PlanEntriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanEntriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanEntriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanEntriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanEntriesResponseValidationError{}
+
+// Validate checks the field values on FlapDamping with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FlapDamping) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlapDamping with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FlapDampingMultiError, or
+// nil if none found.
+func (m *FlapDamping) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlapDamping) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxFlaps
+
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FlapDampingValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FlapDampingValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FlapDampingValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSuppress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FlapDampingValidationError{
+					field:  "Suppress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FlapDampingValidationError{
+					field:  "Suppress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSuppress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FlapDampingValidationError{
+				field:  "Suppress",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FlapDampingMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlapDampingMultiError is an error wrapping multiple validation errors
+// returned by FlapDamping.ValidateAll() if the designated constraints aren't met.
+type FlapDampingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlapDampingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlapDampingMultiError) AllErrors() []error { return m }
+
+// FlapDampingValidationError is the validation error returned by
+// FlapDamping.Validate if the designated constraints aren't met.
+type FlapDampingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlapDampingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlapDampingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlapDampingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlapDampingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlapDampingValidationError) ErrorName() string { return "FlapDampingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FlapDampingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlapDamping.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlapDampingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlapDampingValidationError{}
+
+// Validate checks the field values on HealthPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HealthPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HealthPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HealthPolicyMultiError, or
+// nil if none found.
+func (m *HealthPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HealthPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HealthyThreshold
+
+	// no validation rules for UnhealthyThreshold
+
+	if all {
+		switch v := interface{}(m.GetFlapDamping()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HealthPolicyValidationError{
+					field:  "FlapDamping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HealthPolicyValidationError{
+					field:  "FlapDamping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFlapDamping()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HealthPolicyValidationError{
+				field:  "FlapDamping",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTls()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HealthPolicyValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HealthPolicyValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HealthPolicyValidationError{
+				field:  "Tls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CrossDcQuorum
+
+	if len(errors) > 0 {
+		return HealthPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// HealthPolicyMultiError is an error wrapping multiple validation errors
+// returned by HealthPolicy.ValidateAll() if the designated constraints aren't met.
+type HealthPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HealthPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HealthPolicyMultiError) AllErrors() []error { return m }
+
+// HealthPolicyValidationError is the validation error returned by
+// HealthPolicy.Validate if the designated constraints aren't met.
+type HealthPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HealthPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HealthPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HealthPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HealthPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HealthPolicyValidationError) ErrorName() string { return "HealthPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HealthPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHealthPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HealthPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HealthPolicyValidationError{}
+
+// Validate checks the field values on HealthTlsPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HealthTlsPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HealthTlsPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HealthTlsPolicyMultiError, or nil if none found.
+func (m *HealthTlsPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HealthTlsPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientCert
+
+	// no validation rules for ClientKey
+
+	// no validation rules for MinVersion
+
+	if len(errors) > 0 {
+		return HealthTlsPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// HealthTlsPolicyMultiError is an error wrapping multiple validation errors
+// returned by HealthTlsPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type HealthTlsPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HealthTlsPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HealthTlsPolicyMultiError) AllErrors() []error { return m }
+
+// HealthTlsPolicyValidationError is the validation error returned by
+// HealthTlsPolicy.Validate if the designated constraints aren't met.
+type HealthTlsPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HealthTlsPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HealthTlsPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HealthTlsPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HealthTlsPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HealthTlsPolicyValidationError) ErrorName() string { return "HealthTlsPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HealthTlsPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHealthTlsPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HealthTlsPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HealthTlsPolicyValidationError{}
+
+// Validate checks the field values on AdaptiveWeightPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdaptiveWeightPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdaptiveWeightPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdaptiveWeightPolicyMultiError, or nil if none found.
+func (m *AdaptiveWeightPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdaptiveWeightPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetMetric()) < 1 {
+		err := AdaptiveWeightPolicyValidationError{
+			field:  "Metric",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IpLabel
+
+	// no validation rules for HigherIsBetter
+
+	// no validation rules for MinWeight
+
+	// no validation rules for MaxWeight
+
+	if val := m.GetSmoothing(); val < 0 || val > 1 {
+		err := AdaptiveWeightPolicyValidationError{
+			field:  "Smoothing",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdaptiveWeightPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdaptiveWeightPolicyMultiError is an error wrapping multiple validation
+// errors returned by AdaptiveWeightPolicy.ValidateAll() if the designated
+// constraints aren't met.
+type AdaptiveWeightPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdaptiveWeightPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdaptiveWeightPolicyMultiError) AllErrors() []error { return m }
+
+// AdaptiveWeightPolicyValidationError is the validation error returned by
+// AdaptiveWeightPolicy.Validate if the designated constraints aren't met.
+type AdaptiveWeightPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdaptiveWeightPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdaptiveWeightPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdaptiveWeightPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdaptiveWeightPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdaptiveWeightPolicyValidationError) ErrorName() string {
+	return "AdaptiveWeightPolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdaptiveWeightPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdaptiveWeightPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdaptiveWeightPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdaptiveWeightPolicyValidationError{}
+
+// Validate checks the field values on PriorityGroupPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PriorityGroupPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PriorityGroupPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PriorityGroupPolicyMultiError, or nil if none found.
+func (m *PriorityGroupPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PriorityGroupPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetGroups()) < 1 {
+		err := PriorityGroupPolicyValidationError{
+			field:  "Groups",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PriorityGroupPolicyValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PriorityGroupPolicyValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PriorityGroupPolicyValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MinActiveMembers
+
+	if m.GetMinActivePercent() > 100 {
+		err := PriorityGroupPolicyValidationError{
+			field:  "MinActivePercent",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PriorityGroupPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PriorityGroupPolicyMultiError is an error wrapping multiple validation
+// errors returned by PriorityGroupPolicy.ValidateAll() if the designated
+// constraints aren't met.
+type PriorityGroupPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PriorityGroupPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PriorityGroupPolicyMultiError) AllErrors() []error { return m }
+
+// PriorityGroupPolicyValidationError is the validation error returned by
+// PriorityGroupPolicy.Validate if the designated constraints aren't met.
+type PriorityGroupPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PriorityGroupPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PriorityGroupPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PriorityGroupPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PriorityGroupPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PriorityGroupPolicyValidationError) ErrorName() string {
+	return "PriorityGroupPolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PriorityGroupPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPriorityGroupPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PriorityGroupPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PriorityGroupPolicyValidationError{}
+
+// Validate checks the field values on SlowStartPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SlowStartPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SlowStartPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SlowStartPolicyMultiError, or nil if none found.
+func (m *SlowStartPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SlowStartPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetWindow() == nil {
+		err := SlowStartPolicyValidationError{
+			field:  "Window",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetWindow(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = SlowStartPolicyValidationError{
+				field:  "Window",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := SlowStartPolicyValidationError{
+					field:  "Window",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if m.GetMinPercent() > 100 {
+		err := SlowStartPolicyValidationError{
+			field:  "MinPercent",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SlowStartPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SlowStartPolicyMultiError is an error wrapping multiple validation errors
+// returned by SlowStartPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type SlowStartPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SlowStartPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SlowStartPolicyMultiError) AllErrors() []error { return m }
+
+// SlowStartPolicyValidationError is the validation error returned by
+// SlowStartPolicy.Validate if the designated constraints aren't met.
+type SlowStartPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SlowStartPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SlowStartPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SlowStartPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SlowStartPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SlowStartPolicyValidationError) ErrorName() string { return "SlowStartPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SlowStartPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSlowStartPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SlowStartPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SlowStartPolicyValidationError{}
+
+// Validate checks the field values on ClientAffinityPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClientAffinityPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClientAffinityPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClientAffinityPolicyMultiError, or nil if none found.
+func (m *ClientAffinityPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClientAffinityPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetIpv4PrefixLength() > 32 {
+		err := ClientAffinityPolicyValidationError{
+			field:  "Ipv4PrefixLength",
+			reason: "value must be less than or equal to 32",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetIpv6PrefixLength() > 128 {
+		err := ClientAffinityPolicyValidationError{
+			field:  "Ipv6PrefixLength",
+			reason: "value must be less than or equal to 128",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClientAffinityPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClientAffinityPolicyMultiError is an error wrapping multiple validation
+// errors returned by ClientAffinityPolicy.ValidateAll() if the designated
+// constraints aren't met.
+type ClientAffinityPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClientAffinityPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClientAffinityPolicyMultiError) AllErrors() []error { return m }
+
+// ClientAffinityPolicyValidationError is the validation error returned by
+// ClientAffinityPolicy.Validate if the designated constraints aren't met.
+type ClientAffinityPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClientAffinityPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClientAffinityPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClientAffinityPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClientAffinityPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClientAffinityPolicyValidationError) ErrorName() string {
+	return "ClientAffinityPolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClientAffinityPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClientAffinityPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClientAffinityPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClientAffinityPolicyValidationError{}
+
+// Validate checks the field values on EntryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EntryPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EntryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EntryPolicyMultiError, or
+// nil if none found.
+func (m *EntryPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EntryPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFqdn()) < 1 {
+		err := EntryPolicyValidationError{
+			field:  "Fqdn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetHealth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntryPolicyValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntryPolicyValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntryPolicyValidationError{
+				field:  "Health",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAdaptiveWeights()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntryPolicyValidationError{
+					field:  "AdaptiveWeights",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntryPolicyValidationError{
+					field:  "AdaptiveWeights",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAdaptiveWeights()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntryPolicyValidationError{
+				field:  "AdaptiveWeights",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPriorityGroups()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntryPolicyValidationError{
+					field:  "PriorityGroups",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntryPolicyValidationError{
+					field:  "PriorityGroups",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriorityGroups()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntryPolicyValidationError{
+				field:  "PriorityGroups",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSlowStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntryPolicyValidationError{
+					field:  "SlowStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntryPolicyValidationError{
+					field:  "SlowStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSlowStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntryPolicyValidationError{
+				field:  "SlowStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetClientAffinity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntryPolicyValidationError{
+					field:  "ClientAffinity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntryPolicyValidationError{
+					field:  "ClientAffinity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClientAffinity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntryPolicyValidationError{
+				field:  "ClientAffinity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EntryPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// EntryPolicyMultiError is an error wrapping multiple validation errors
+// returned by EntryPolicy.ValidateAll() if the designated constraints aren't met.
+type EntryPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EntryPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EntryPolicyMultiError) AllErrors() []error { return m }
+
+// EntryPolicyValidationError is the validation error returned by
+// EntryPolicy.Validate if the designated constraints aren't met.
+type EntryPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EntryPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EntryPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EntryPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EntryPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EntryPolicyValidationError) ErrorName() string { return "EntryPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EntryPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEntryPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EntryPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EntryPolicyValidationError{}
+
+// Validate checks the field values on SetEntryPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetEntryPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetEntryPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetEntryPolicyRequestMultiError, or nil if none found.
+func (m *SetEntryPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetEntryPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPolicy() == nil {
+		err := SetEntryPolicyRequestValidationError{
+			field:  "Policy",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetEntryPolicyRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetEntryPolicyRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetEntryPolicyRequestValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetEntryPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetEntryPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by SetEntryPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetEntryPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetEntryPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetEntryPolicyRequestMultiError) AllErrors() []error { return m }
+
+// SetEntryPolicyRequestValidationError is the validation error returned by
+// SetEntryPolicyRequest.Validate if the designated constraints aren't met.
+type SetEntryPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetEntryPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetEntryPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetEntryPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetEntryPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetEntryPolicyRequestValidationError) ErrorName() string {
+	return "SetEntryPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetEntryPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetEntryPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetEntryPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetEntryPolicyRequestValidationError{}
+
+// Validate checks the field values on GetEntryPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEntryPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEntryPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEntryPolicyRequestMultiError, or nil if none found.
+func (m *GetEntryPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEntryPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFqdn()) < 1 {
+		err := GetEntryPolicyRequestValidationError{
+			field:  "Fqdn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetEntryPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEntryPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by GetEntryPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetEntryPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEntryPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEntryPolicyRequestMultiError) AllErrors() []error { return m }
+
+// GetEntryPolicyRequestValidationError is the validation error returned by
+// GetEntryPolicyRequest.Validate if the designated constraints aren't met.
+type GetEntryPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEntryPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEntryPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEntryPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEntryPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEntryPolicyRequestValidationError) ErrorName() string {
+	return "GetEntryPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEntryPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEntryPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEntryPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEntryPolicyRequestValidationError{}
+
+// Validate checks the field values on DeleteEntryPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteEntryPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteEntryPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteEntryPolicyRequestMultiError, or nil if none found.
+func (m *DeleteEntryPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteEntryPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFqdn()) < 1 {
+		err := DeleteEntryPolicyRequestValidationError{
+			field:  "Fqdn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteEntryPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteEntryPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteEntryPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteEntryPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteEntryPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteEntryPolicyRequestMultiError) AllErrors() []error { return m }
+
+// DeleteEntryPolicyRequestValidationError is the validation error returned by
+// DeleteEntryPolicyRequest.Validate if the designated constraints aren't met.
+type DeleteEntryPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteEntryPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteEntryPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteEntryPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteEntryPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteEntryPolicyRequestValidationError) ErrorName() string {
+	return "DeleteEntryPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteEntryPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteEntryPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteEntryPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteEntryPolicyRequestValidationError{}
+
+// Validate checks the field values on ProbeRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProbeRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProbeRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProbeRecordMultiError, or
+// nil if none found.
+func (m *ProbeRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProbeRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProbeRecordValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProbeRecordValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProbeRecordValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProbeRecordValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProbeRecordValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProbeRecordValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Success
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return ProbeRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProbeRecordMultiError is an error wrapping multiple validation errors
+// returned by ProbeRecord.ValidateAll() if the designated constraints aren't met.
+type ProbeRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProbeRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProbeRecordMultiError) AllErrors() []error { return m }
+
+// ProbeRecordValidationError is the validation error returned by
+// ProbeRecord.Validate if the designated constraints aren't met.
+type ProbeRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProbeRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProbeRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProbeRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProbeRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProbeRecordValidationError) ErrorName() string { return "ProbeRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProbeRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProbeRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProbeRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProbeRecordValidationError{}
+
+// Validate checks the field values on MemberHealthState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MemberHealthState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemberHealthState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MemberHealthStateMultiError, or nil if none found.
+func (m *MemberHealthState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemberHealthState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Healthy
+
+	// no validation rules for ConsecutiveSuccesses
+
+	// no validation rules for ConsecutiveFailures
+
+	// no validation rules for HealthyThreshold
+
+	// no validation rules for UnhealthyThreshold
+
+	if all {
+		switch v := interface{}(m.GetDampedUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberHealthStateValidationError{
+					field:  "DampedUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberHealthStateValidationError{
+					field:  "DampedUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDampedUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberHealthStateValidationError{
+				field:  "DampedUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCrossDc()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberHealthStateValidationError{
+					field:  "CrossDc",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberHealthStateValidationError{
+					field:  "CrossDc",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCrossDc()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberHealthStateValidationError{
+				field:  "CrossDc",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MemberHealthStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// MemberHealthStateMultiError is an error wrapping multiple validation errors
+// returned by MemberHealthState.ValidateAll() if the designated constraints
+// aren't met.
+type MemberHealthStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MemberHealthStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MemberHealthStateMultiError) AllErrors() []error { return m }
+
+// MemberHealthStateValidationError is the validation error returned by
+// MemberHealthState.Validate if the designated constraints aren't met.
+type MemberHealthStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MemberHealthStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MemberHealthStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MemberHealthStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MemberHealthStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MemberHealthStateValidationError) ErrorName() string {
+	return "MemberHealthStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MemberHealthStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMemberHealthState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MemberHealthStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MemberHealthStateValidationError{}
+
+// Validate checks the field values on DcHealth with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DcHealth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DcHealth with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DcHealthMultiError, or nil
+// if none found.
+func (m *DcHealth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DcHealth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Dc
+
+	// no validation rules for Healthy
+
+	// no validation rules for Output
+
+	if len(errors) > 0 {
+		return DcHealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// DcHealthMultiError is an error wrapping multiple validation errors returned
+// by DcHealth.ValidateAll() if the designated constraints aren't met.
+type DcHealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DcHealthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DcHealthMultiError) AllErrors() []error { return m }
+
+// DcHealthValidationError is the validation error returned by
+// DcHealth.Validate if the designated constraints aren't met.
+type DcHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DcHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DcHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DcHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DcHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DcHealthValidationError) ErrorName() string { return "DcHealthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DcHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDcHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DcHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DcHealthValidationError{}
+
+// Validate checks the field values on CrossDcHealth with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CrossDcHealth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CrossDcHealth with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CrossDcHealthMultiError, or
+// nil if none found.
+func (m *CrossDcHealth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CrossDcHealth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Healthy
+
+	// no validation rules for Unhealthy
+
+	// no validation rules for Quorum
+
+	for idx, item := range m.GetDcs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CrossDcHealthValidationError{
+						field:  fmt.Sprintf("Dcs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CrossDcHealthValidationError{
+						field:  fmt.Sprintf("Dcs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CrossDcHealthValidationError{
+					field:  fmt.Sprintf("Dcs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CrossDcHealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// CrossDcHealthMultiError is an error wrapping multiple validation errors
+// returned by CrossDcHealth.ValidateAll() if the designated constraints
+// aren't met.
+type CrossDcHealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CrossDcHealthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CrossDcHealthMultiError) AllErrors() []error { return m }
+
+// CrossDcHealthValidationError is the validation error returned by
+// CrossDcHealth.Validate if the designated constraints aren't met.
+type CrossDcHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CrossDcHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CrossDcHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CrossDcHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CrossDcHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CrossDcHealthValidationError) ErrorName() string { return "CrossDcHealthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CrossDcHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCrossDcHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CrossDcHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CrossDcHealthValidationError{}
+
+// Validate checks the field values on MemberHealthHistory with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MemberHealthHistory) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemberHealthHistory with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MemberHealthHistoryMultiError, or nil if none found.
+func (m *MemberHealthHistory) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemberHealthHistory) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ip
+
+	// no validation rules for Dc
+
+	for idx, item := range m.GetProbes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MemberHealthHistoryValidationError{
+						field:  fmt.Sprintf("Probes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MemberHealthHistoryValidationError{
+						field:  fmt.Sprintf("Probes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MemberHealthHistoryValidationError{
+					field:  fmt.Sprintf("Probes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberHealthHistoryValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberHealthHistoryValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberHealthHistoryValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MemberHealthHistoryMultiError(errors)
+	}
+
+	return nil
+}
+
+// MemberHealthHistoryMultiError is an error wrapping multiple validation
+// errors returned by MemberHealthHistory.ValidateAll() if the designated
+// constraints aren't met.
+type MemberHealthHistoryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MemberHealthHistoryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MemberHealthHistoryMultiError) AllErrors() []error { return m }
+
+// MemberHealthHistoryValidationError is the validation error returned by
+// MemberHealthHistory.Validate if the designated constraints aren't met.
+type MemberHealthHistoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MemberHealthHistoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MemberHealthHistoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MemberHealthHistoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MemberHealthHistoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MemberHealthHistoryValidationError) ErrorName() string {
+	return "MemberHealthHistoryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MemberHealthHistoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMemberHealthHistory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MemberHealthHistoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MemberHealthHistoryValidationError{}
+
+// Validate checks the field values on GetMemberHealthHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMemberHealthHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMemberHealthHistoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetMemberHealthHistoryRequestMultiError, or nil if none found.
+func (m *GetMemberHealthHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMemberHealthHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFqdn()) < 1 {
+		err := GetMemberHealthHistoryRequestValidationError{
+			field:  "Fqdn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Ip
+
+	if len(errors) > 0 {
+		return GetMemberHealthHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMemberHealthHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetMemberHealthHistoryRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetMemberHealthHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMemberHealthHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMemberHealthHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetMemberHealthHistoryRequestValidationError is the validation error
+// returned by GetMemberHealthHistoryRequest.Validate if the designated
+// constraints aren't met.
+type GetMemberHealthHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMemberHealthHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMemberHealthHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMemberHealthHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMemberHealthHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMemberHealthHistoryRequestValidationError) ErrorName() string {
+	return "GetMemberHealthHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMemberHealthHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMemberHealthHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMemberHealthHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMemberHealthHistoryRequestValidationError{}
+
+// Validate checks the field values on GetMemberHealthHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMemberHealthHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMemberHealthHistoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetMemberHealthHistoryResponseMultiError, or nil if none found.
+func (m *GetMemberHealthHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMemberHealthHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMemberHealthHistoryResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMemberHealthHistoryResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMemberHealthHistoryResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMemberHealthHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMemberHealthHistoryResponseMultiError is an error wrapping multiple
+// validation errors returned by GetMemberHealthHistoryResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetMemberHealthHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMemberHealthHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMemberHealthHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetMemberHealthHistoryResponseValidationError is the validation error
+// returned by GetMemberHealthHistoryResponse.Validate if the designated
+// constraints aren't met.
+type GetMemberHealthHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMemberHealthHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMemberHealthHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMemberHealthHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMemberHealthHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMemberHealthHistoryResponseValidationError) ErrorName() string {
+	return "GetMemberHealthHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMemberHealthHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMemberHealthHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMemberHealthHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMemberHealthHistoryResponseValidationError{}
+
+// Validate checks the field values on DcMemberHealth with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DcMemberHealth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DcMemberHealth with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DcMemberHealthMultiError,
+// or nil if none found.
+func (m *DcMemberHealth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DcMemberHealth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ip
+
+	// no validation rules for Healthy
+
+	// no validation rules for Output
+
+	if len(errors) > 0 {
+		return DcMemberHealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// DcMemberHealthMultiError is an error wrapping multiple validation errors
+// returned by DcMemberHealth.ValidateAll() if the designated constraints
+// aren't met.
+type DcMemberHealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DcMemberHealthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DcMemberHealthMultiError) AllErrors() []error { return m }
+
+// DcMemberHealthValidationError is the validation error returned by
+// DcMemberHealth.Validate if the designated constraints aren't met.
+type DcMemberHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DcMemberHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DcMemberHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DcMemberHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DcMemberHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DcMemberHealthValidationError) ErrorName() string { return "DcMemberHealthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DcMemberHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDcMemberHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DcMemberHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DcMemberHealthValidationError{}
+
+// Validate checks the field values on DcHealthReport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DcHealthReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DcHealthReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DcHealthReportMultiError,
+// or nil if none found.
+func (m *DcHealthReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DcHealthReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Dc
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DcHealthReportValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DcHealthReportValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DcHealthReportValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DcHealthReportValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DcHealthReportValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DcHealthReportValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DcHealthReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// DcHealthReportMultiError is an error wrapping multiple validation errors
+// returned by DcHealthReport.ValidateAll() if the designated constraints
+// aren't met.
+type DcHealthReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DcHealthReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DcHealthReportMultiError) AllErrors() []error { return m }
+
+// DcHealthReportValidationError is the validation error returned by
+// DcHealthReport.Validate if the designated constraints aren't met.
+type DcHealthReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DcHealthReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DcHealthReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DcHealthReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DcHealthReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DcHealthReportValidationError) ErrorName() string { return "DcHealthReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DcHealthReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDcHealthReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DcHealthReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DcHealthReportValidationError{}
+
+// Validate checks the field values on TestHealthCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TestHealthCheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestHealthCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TestHealthCheckRequestMultiError, or nil if none found.
+func (m *TestHealthCheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestHealthCheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFqdn()) < 1 {
+		err := TestHealthCheckRequestValidationError{
+			field:  "Fqdn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetHealthcheck() == nil {
+		err := TestHealthCheckRequestValidationError{
+			field:  "Healthcheck",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetHealthcheck()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TestHealthCheckRequestValidationError{
+					field:  "Healthcheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TestHealthCheckRequestValidationError{
+					field:  "Healthcheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealthcheck()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TestHealthCheckRequestValidationError{
+				field:  "Healthcheck",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetIps()) < 1 {
+		err := TestHealthCheckRequestValidationError{
+			field:  "Ips",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIps() {
+		_, _ = idx, item
+
+		if ip := net.ParseIP(item); ip == nil {
+			err := TestHealthCheckRequestValidationError{
+				field:  fmt.Sprintf("Ips[%v]", idx),
+				reason: "value must be a valid IP address",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TestHealthCheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestHealthCheckRequestMultiError is an error wrapping multiple validation
+// errors returned by TestHealthCheckRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TestHealthCheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestHealthCheckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestHealthCheckRequestMultiError) AllErrors() []error { return m }
+
+// TestHealthCheckRequestValidationError is the validation error returned by
+// TestHealthCheckRequest.Validate if the designated constraints aren't met.
+type TestHealthCheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestHealthCheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestHealthCheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestHealthCheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestHealthCheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestHealthCheckRequestValidationError) ErrorName() string {
+	return "TestHealthCheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestHealthCheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestHealthCheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestHealthCheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestHealthCheckRequestValidationError{}
+
+// Validate checks the field values on MemberCheckResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MemberCheckResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemberCheckResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MemberCheckResultMultiError, or nil if none found.
+func (m *MemberCheckResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemberCheckResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ip
+
+	// no validation rules for Success
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberCheckResultValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberCheckResultValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberCheckResultValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	// no validation rules for Details
+
+	if len(errors) > 0 {
+		return MemberCheckResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// MemberCheckResultMultiError is an error wrapping multiple validation errors
+// returned by MemberCheckResult.ValidateAll() if the designated constraints
+// aren't met.
+type MemberCheckResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MemberCheckResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MemberCheckResultMultiError) AllErrors() []error { return m }
+
+// MemberCheckResultValidationError is the validation error returned by
+// MemberCheckResult.Validate if the designated constraints aren't met.
+type MemberCheckResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MemberCheckResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MemberCheckResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MemberCheckResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MemberCheckResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MemberCheckResultValidationError) ErrorName() string {
+	return "MemberCheckResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MemberCheckResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMemberCheckResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MemberCheckResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MemberCheckResultValidationError{}
+
+// Validate checks the field values on TestHealthCheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TestHealthCheckResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestHealthCheckResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TestHealthCheckResponseMultiError, or nil if none found.
+func (m *TestHealthCheckResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestHealthCheckResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TestHealthCheckResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TestHealthCheckResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TestHealthCheckResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TestHealthCheckResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestHealthCheckResponseMultiError is an error wrapping multiple validation
+// errors returned by TestHealthCheckResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TestHealthCheckResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestHealthCheckResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestHealthCheckResponseMultiError) AllErrors() []error { return m }
+
+// TestHealthCheckResponseValidationError is the validation error returned by
+// TestHealthCheckResponse.Validate if the designated constraints aren't met.
+type TestHealthCheckResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestHealthCheckResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestHealthCheckResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestHealthCheckResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestHealthCheckResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestHealthCheckResponseValidationError) ErrorName() string {
+	return "TestHealthCheckResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestHealthCheckResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestHealthCheckResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestHealthCheckResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestHealthCheckResponseValidationError{}
+
+// Validate checks the field values on RecheckMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecheckMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecheckMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecheckMemberRequestMultiError, or nil if none found.
+func (m *RecheckMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecheckMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFqdn()) < 1 {
+		err := RecheckMemberRequestValidationError{
+			field:  "Fqdn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		err := RecheckMemberRequestValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RecheckMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecheckMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by RecheckMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecheckMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecheckMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecheckMemberRequestMultiError) AllErrors() []error { return m }
+
+// RecheckMemberRequestValidationError is the validation error returned by
+// RecheckMemberRequest.Validate if the designated constraints aren't met.
+type RecheckMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecheckMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecheckMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecheckMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecheckMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecheckMemberRequestValidationError) ErrorName() string {
+	return "RecheckMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecheckMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecheckMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecheckMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecheckMemberRequestValidationError{}
+
+// Validate checks the field values on RecheckMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecheckMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecheckMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecheckMemberResponseMultiError, or nil if none found.
+func (m *RecheckMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecheckMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Healthy
+
+	// no validation rules for Output
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecheckMemberResponseValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecheckMemberResponseValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecheckMemberResponseValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RecheckMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecheckMemberResponseMultiError is an error wrapping multiple validation
+// errors returned by RecheckMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RecheckMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecheckMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecheckMemberResponseMultiError) AllErrors() []error { return m }
+
+// RecheckMemberResponseValidationError is the validation error returned by
+// RecheckMemberResponse.Validate if the designated constraints aren't met.
+type RecheckMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecheckMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecheckMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecheckMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecheckMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecheckMemberResponseValidationError) ErrorName() string {
+	return "RecheckMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecheckMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecheckMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecheckMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecheckMemberResponseValidationError{}
+
+// Validate checks the field values on MemberSignal with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MemberSignal) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemberSignal with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MemberSignalMultiError, or
+// nil if none found.
+func (m *MemberSignal) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemberSignal) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fqdn
+
+	// no validation rules for Ip
+
+	// no validation rules for Level
+
+	// no validation rules for Reason
+
+	// no validation rules for Reporter
+
+	if all {
+		switch v := interface{}(m.GetReportedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberSignalValidationError{
+					field:  "ReportedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberSignalValidationError{
+					field:  "ReportedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReportedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberSignalValidationError{
+				field:  "ReportedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberSignalValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberSignalValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberSignalValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Signature
+
+	if len(errors) > 0 {
+		return MemberSignalMultiError(errors)
+	}
+
+	return nil
+}
+
+// MemberSignalMultiError is an error wrapping multiple validation errors
+// returned by MemberSignal.ValidateAll() if the designated constraints aren't met.
+type MemberSignalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MemberSignalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MemberSignalMultiError) AllErrors() []error { return m }
+
+// MemberSignalValidationError is the validation error returned by
+// MemberSignal.Validate if the designated constraints aren't met.
+type MemberSignalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MemberSignalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MemberSignalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MemberSignalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MemberSignalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MemberSignalValidationError) ErrorName() string { return "MemberSignalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MemberSignalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMemberSignal.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MemberSignalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MemberSignalValidationError{}
+
+// Validate checks the field values on ReportMemberSignalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportMemberSignalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportMemberSignalRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportMemberSignalRequestMultiError, or nil if none found.
+func (m *ReportMemberSignalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportMemberSignalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFqdn()) < 1 {
+		err := ReportMemberSignalRequestValidationError{
+			field:  "Fqdn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		err := ReportMemberSignalRequestValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ReportMemberSignalRequest_Level_NotInLookup[m.GetLevel()]; ok {
+		err := ReportMemberSignalRequestValidationError{
+			field:  "Level",
+			reason: "value must not be in list [LEVEL_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := MemberSignal_Level_name[int32(m.GetLevel())]; !ok {
+		err := ReportMemberSignalRequestValidationError{
+			field:  "Level",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Reason
+
+	if m.GetDuration() == nil {
+		err := ReportMemberSignalRequestValidationError{
+			field:  "Duration",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetDuration(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ReportMemberSignalRequestValidationError{
+				field:  "Duration",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := ReportMemberSignalRequestValidationError{
+					field:  "Duration",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReportMemberSignalRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportMemberSignalRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportMemberSignalRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ReportMemberSignalRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportMemberSignalRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportMemberSignalRequestMultiError) AllErrors() []error { return m }
+
+// ReportMemberSignalRequestValidationError is the validation error returned by
+// ReportMemberSignalRequest.Validate if the designated constraints aren't met.
+type ReportMemberSignalRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportMemberSignalRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportMemberSignalRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportMemberSignalRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportMemberSignalRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportMemberSignalRequestValidationError) ErrorName() string {
+	return "ReportMemberSignalRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportMemberSignalRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportMemberSignalRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportMemberSignalRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportMemberSignalRequestValidationError{}
+
+var _ReportMemberSignalRequest_Level_NotInLookup = map[MemberSignal_Level]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ClearMemberSignalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClearMemberSignalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearMemberSignalRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClearMemberSignalRequestMultiError, or nil if none found.
+func (m *ClearMemberSignalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearMemberSignalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFqdn()) < 1 {
+		err := ClearMemberSignalRequestValidationError{
+			field:  "Fqdn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if ip := net.ParseIP(m.GetIp()); ip == nil {
+		err := ClearMemberSignalRequestValidationError{
+			field:  "Ip",
+			reason: "value must be a valid IP address",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClearMemberSignalRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearMemberSignalRequestMultiError is an error wrapping multiple validation
+// errors returned by ClearMemberSignalRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ClearMemberSignalRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearMemberSignalRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearMemberSignalRequestMultiError) AllErrors() []error { return m }
+
+// ClearMemberSignalRequestValidationError is the validation error returned by
+// ClearMemberSignalRequest.Validate if the designated constraints aren't met.
+type ClearMemberSignalRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearMemberSignalRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearMemberSignalRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearMemberSignalRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearMemberSignalRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearMemberSignalRequestValidationError) ErrorName() string {
+	return "ClearMemberSignalRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClearMemberSignalRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearMemberSignalRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearMemberSignalRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearMemberSignalRequestValidationError{}
+
+// Validate checks the field values on ListMemberSignalsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMemberSignalsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMemberSignalsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMemberSignalsRequestMultiError, or nil if none found.
+func (m *ListMemberSignalsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMemberSignalsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fqdn
+
+	if len(errors) > 0 {
+		return ListMemberSignalsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMemberSignalsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMemberSignalsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMemberSignalsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMemberSignalsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMemberSignalsRequestMultiError) AllErrors() []error { return m }
+
+// ListMemberSignalsRequestValidationError is the validation error returned by
+// ListMemberSignalsRequest.Validate if the designated constraints aren't met.
+type ListMemberSignalsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMemberSignalsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMemberSignalsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMemberSignalsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMemberSignalsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMemberSignalsRequestValidationError) ErrorName() string {
+	return "ListMemberSignalsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMemberSignalsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMemberSignalsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMemberSignalsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMemberSignalsRequestValidationError{}
+
+// Validate checks the field values on ListMemberSignalsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMemberSignalsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMemberSignalsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMemberSignalsResponseMultiError, or nil if none found.
+func (m *ListMemberSignalsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMemberSignalsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSignals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMemberSignalsResponseValidationError{
+						field:  fmt.Sprintf("Signals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMemberSignalsResponseValidationError{
+						field:  fmt.Sprintf("Signals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMemberSignalsResponseValidationError{
+					field:  fmt.Sprintf("Signals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMemberSignalsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMemberSignalsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMemberSignalsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListMemberSignalsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMemberSignalsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMemberSignalsResponseMultiError) AllErrors() []error { return m }
+
+// ListMemberSignalsResponseValidationError is the validation error returned by
+// ListMemberSignalsResponse.Validate if the designated constraints aren't met.
+type ListMemberSignalsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMemberSignalsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMemberSignalsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMemberSignalsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMemberSignalsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMemberSignalsResponseValidationError) ErrorName() string {
+	return "ListMemberSignalsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMemberSignalsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMemberSignalsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMemberSignalsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMemberSignalsResponseValidationError{}
+
+// Validate checks the field values on MemberWeight with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MemberWeight) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemberWeight with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MemberWeightMultiError, or
+// nil if none found.
+func (m *MemberWeight) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemberWeight) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ip
+
+	// no validation rules for Weight
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return MemberWeightMultiError(errors)
+	}
+
+	return nil
+}
+
+// MemberWeightMultiError is an error wrapping multiple validation errors
+// returned by MemberWeight.ValidateAll() if the designated constraints aren't met.
+type MemberWeightMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MemberWeightMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MemberWeightMultiError) AllErrors() []error { return m }
+
+// MemberWeightValidationError is the validation error returned by
+// MemberWeight.Validate if the designated constraints aren't met.
+type MemberWeightValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MemberWeightValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MemberWeightValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MemberWeightValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MemberWeightValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MemberWeightValidationError) ErrorName() string { return "MemberWeightValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MemberWeightValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMemberWeight.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MemberWeightValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MemberWeightValidationError{}
+
+// Validate checks the field values on EffectiveWeights with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EffectiveWeights) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EffectiveWeights with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EffectiveWeightsMultiError, or nil if none found.
+func (m *EffectiveWeights) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EffectiveWeights) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fqdn
+
+	// no validation rules for Dc
+
+	if all {
+		switch v := interface{}(m.GetComputedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EffectiveWeightsValidationError{
+					field:  "ComputedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EffectiveWeightsValidationError{
+					field:  "ComputedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComputedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EffectiveWeightsValidationError{
+				field:  "ComputedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EffectiveWeightsValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EffectiveWeightsValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EffectiveWeightsValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EffectiveWeightsValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EffectiveWeightsValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EffectiveWeightsValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EffectiveWeightsMultiError(errors)
+	}
+
+	return nil
+}
+
+// EffectiveWeightsMultiError is an error wrapping multiple validation errors
+// returned by EffectiveWeights.ValidateAll() if the designated constraints
+// aren't met.
+type EffectiveWeightsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EffectiveWeightsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EffectiveWeightsMultiError) AllErrors() []error { return m }
+
+// EffectiveWeightsValidationError is the validation error returned by
+// EffectiveWeights.Validate if the designated constraints aren't met.
+type EffectiveWeightsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EffectiveWeightsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EffectiveWeightsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EffectiveWeightsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EffectiveWeightsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EffectiveWeightsValidationError) ErrorName() string { return "EffectiveWeightsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EffectiveWeightsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEffectiveWeights.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EffectiveWeightsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EffectiveWeightsValidationError{}
+
+// Validate checks the field values on ListEffectiveWeightsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEffectiveWeightsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEffectiveWeightsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEffectiveWeightsRequestMultiError, or nil if none found.
+func (m *ListEffectiveWeightsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEffectiveWeightsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fqdn
+
+	if len(errors) > 0 {
+		return ListEffectiveWeightsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEffectiveWeightsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListEffectiveWeightsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListEffectiveWeightsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEffectiveWeightsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEffectiveWeightsRequestMultiError) AllErrors() []error { return m }
+
+// ListEffectiveWeightsRequestValidationError is the validation error returned
+// by ListEffectiveWeightsRequest.Validate if the designated constraints
+// aren't met.
+type ListEffectiveWeightsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEffectiveWeightsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEffectiveWeightsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEffectiveWeightsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEffectiveWeightsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEffectiveWeightsRequestValidationError) ErrorName() string {
+	return "ListEffectiveWeightsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEffectiveWeightsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEffectiveWeightsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEffectiveWeightsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEffectiveWeightsRequestValidationError{}
+
+// Validate checks the field values on ListEffectiveWeightsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEffectiveWeightsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEffectiveWeightsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEffectiveWeightsResponseMultiError, or nil if none found.
+func (m *ListEffectiveWeightsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEffectiveWeightsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWeights() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEffectiveWeightsResponseValidationError{
+						field:  fmt.Sprintf("Weights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEffectiveWeightsResponseValidationError{
+						field:  fmt.Sprintf("Weights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEffectiveWeightsResponseValidationError{
+					field:  fmt.Sprintf("Weights[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListEffectiveWeightsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEffectiveWeightsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListEffectiveWeightsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListEffectiveWeightsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEffectiveWeightsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEffectiveWeightsResponseMultiError) AllErrors() []error { return m }
+
+// ListEffectiveWeightsResponseValidationError is the validation error returned
+// by ListEffectiveWeightsResponse.Validate if the designated constraints
+// aren't met.
+type ListEffectiveWeightsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEffectiveWeightsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEffectiveWeightsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEffectiveWeightsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEffectiveWeightsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEffectiveWeightsResponseValidationError) ErrorName() string {
+	return "ListEffectiveWeightsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEffectiveWeightsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEffectiveWeightsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEffectiveWeightsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEffectiveWeightsResponseValidationError{}
+
+// Validate checks the field values on PriorityGroupPolicy_Group with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PriorityGroupPolicy_Group) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PriorityGroupPolicy_Group with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PriorityGroupPolicy_GroupMultiError, or nil if none found.
+func (m *PriorityGroupPolicy_Group) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PriorityGroupPolicy_Group) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Priority
+
+	for idx, item := range m.GetIps() {
+		_, _ = idx, item
+
+		if ip := net.ParseIP(item); ip == nil {
+			err := PriorityGroupPolicy_GroupValidationError{
+				field:  fmt.Sprintf("Ips[%v]", idx),
+				reason: "value must be a valid IP address",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PriorityGroupPolicy_GroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// PriorityGroupPolicy_GroupMultiError is an error wrapping multiple validation
+// errors returned by PriorityGroupPolicy_Group.ValidateAll() if the
+// designated constraints aren't met.
+type PriorityGroupPolicy_GroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PriorityGroupPolicy_GroupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PriorityGroupPolicy_GroupMultiError) AllErrors() []error { return m }
+
+// PriorityGroupPolicy_GroupValidationError is the validation error returned by
+// PriorityGroupPolicy_Group.Validate if the designated constraints aren't met.
+type PriorityGroupPolicy_GroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PriorityGroupPolicy_GroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PriorityGroupPolicy_GroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PriorityGroupPolicy_GroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PriorityGroupPolicy_GroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PriorityGroupPolicy_GroupValidationError) ErrorName() string {
+	return "PriorityGroupPolicy_GroupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PriorityGroupPolicy_GroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPriorityGroupPolicy_Group.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PriorityGroupPolicy_GroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PriorityGroupPolicy_GroupValidationError{}
@@ -0,0 +1,878 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: gsloc/services/ops/v1/ops.proto
+
+package opssvc
+
+import (
+	context "context"
+	v1 "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Ops_ListEntryRevisions_FullMethodName     = "/gsloc.services.ops.v1.Ops/ListEntryRevisions"
+	Ops_DiffEntryRevisions_FullMethodName     = "/gsloc.services.ops.v1.Ops/DiffEntryRevisions"
+	Ops_RollbackEntry_FullMethodName          = "/gsloc.services.ops.v1.Ops/RollbackEntry"
+	Ops_ListAuditEvents_FullMethodName        = "/gsloc.services.ops.v1.Ops/ListAuditEvents"
+	Ops_ResignEntries_FullMethodName          = "/gsloc.services.ops.v1.Ops/ResignEntries"
+	Ops_ExportEntries_FullMethodName          = "/gsloc.services.ops.v1.Ops/ExportEntries"
+	Ops_ImportEntries_FullMethodName          = "/gsloc.services.ops.v1.Ops/ImportEntries"
+	Ops_PlanEntries_FullMethodName            = "/gsloc.services.ops.v1.Ops/PlanEntries"
+	Ops_SetEntryPolicy_FullMethodName         = "/gsloc.services.ops.v1.Ops/SetEntryPolicy"
+	Ops_GetEntryPolicy_FullMethodName         = "/gsloc.services.ops.v1.Ops/GetEntryPolicy"
+	Ops_DeleteEntryPolicy_FullMethodName      = "/gsloc.services.ops.v1.Ops/DeleteEntryPolicy"
+	Ops_GetMemberHealthHistory_FullMethodName = "/gsloc.services.ops.v1.Ops/GetMemberHealthHistory"
+	Ops_TestHealthCheck_FullMethodName        = "/gsloc.services.ops.v1.Ops/TestHealthCheck"
+	Ops_RecheckMember_FullMethodName          = "/gsloc.services.ops.v1.Ops/RecheckMember"
+	Ops_ReportMemberSignal_FullMethodName     = "/gsloc.services.ops.v1.Ops/ReportMemberSignal"
+	Ops_ClearMemberSignal_FullMethodName      = "/gsloc.services.ops.v1.Ops/ClearMemberSignal"
+	Ops_ListMemberSignals_FullMethodName      = "/gsloc.services.ops.v1.Ops/ListMemberSignals"
+	Ops_ListEffectiveWeights_FullMethodName   = "/gsloc.services.ops.v1.Ops/ListEffectiveWeights"
+)
+
+// OpsClient is the client API for Ops service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OpsClient interface {
+	// ListEntryRevisions list kept revisions of an entry, newest first.
+	ListEntryRevisions(ctx context.Context, in *ListEntryRevisionsRequest, opts ...grpc.CallOption) (*ListEntryRevisionsResponse, error)
+	// DiffEntryRevisions show differences between two revisions of an entry.
+	DiffEntryRevisions(ctx context.Context, in *DiffEntryRevisionsRequest, opts ...grpc.CallOption) (*DiffEntryRevisionsResponse, error)
+	// RollbackEntry set back an entry as it was at given revision.
+	RollbackEntry(ctx context.Context, in *RollbackEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListAuditEvents list audit events of api mutations recorded by the receiving node, oldest first.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// ResignEntries sign again entries with the current signing key, this is used when rotating keys.
+	ResignEntries(ctx context.Context, in *ResignEntriesRequest, opts ...grpc.CallOption) (*ResignEntriesResponse, error)
+	// ExportEntries stream entries matching prefix and tags, signatures are removed.
+	ExportEntries(ctx context.Context, in *ExportEntriesRequest, opts ...grpc.CallOption) (Ops_ExportEntriesClient, error)
+	// ImportEntries receive options as first message and then entries to import.
+	ImportEntries(ctx context.Context, opts ...grpc.CallOption) (Ops_ImportEntriesClient, error)
+	// PlanEntries receive options as first message and then desired entries,
+	// it gives changes needed to reach desired entries and apply them in a single transaction if asked.
+	PlanEntries(ctx context.Context, opts ...grpc.CallOption) (Ops_PlanEntriesClient, error)
+	// SetEntryPolicy set policy applied by gsloc on an entry, it replaces the previous one.
+	SetEntryPolicy(ctx context.Context, in *SetEntryPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetEntryPolicy get policy of an entry, default policy is returned when none has been set.
+	GetEntryPolicy(ctx context.Context, in *GetEntryPolicyRequest, opts ...grpc.CallOption) (*EntryPolicy, error)
+	// DeleteEntryPolicy remove policy of an entry, default policy is then applied.
+	DeleteEntryPolicy(ctx context.Context, in *DeleteEntryPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetMemberHealthHistory list last probes made by the receiving node on members of an entry, newest first.
+	GetMemberHealthHistory(ctx context.Context, in *GetMemberHealthHistoryRequest, opts ...grpc.CallOption) (*GetMemberHealthHistoryResponse, error)
+	// TestHealthCheck runs a health check definition from the receiving node against given ips, nothing is stored.
+	TestHealthCheck(ctx context.Context, in *TestHealthCheckRequest, opts ...grpc.CallOption) (*TestHealthCheckResponse, error)
+	// RecheckMember probes now a member of an entry and push result to consul, receiving node must be in member dc.
+	RecheckMember(ctx context.Context, in *RecheckMemberRequest, opts ...grpc.CallOption) (*RecheckMemberResponse, error)
+	// ReportMemberSignal report a member as degraded or failed for a while, e.g. by an external monitoring,
+	// it replaces previous signal on member. Member is excluded from dns answers until signal expires.
+	ReportMemberSignal(ctx context.Context, in *ReportMemberSignalRequest, opts ...grpc.CallOption) (*MemberSignal, error)
+	// ClearMemberSignal remove signal reported on a member before it expires.
+	ClearMemberSignal(ctx context.Context, in *ClearMemberSignalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListMemberSignals list signals which are not expired.
+	ListMemberSignals(ctx context.Context, in *ListMemberSignalsRequest, opts ...grpc.CallOption) (*ListMemberSignalsResponse, error)
+	// ListEffectiveWeights list weights computed by dcs for members of entries using adaptive weights,
+	// expired ones are not given.
+	ListEffectiveWeights(ctx context.Context, in *ListEffectiveWeightsRequest, opts ...grpc.CallOption) (*ListEffectiveWeightsResponse, error)
+}
+
+type opsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOpsClient(cc grpc.ClientConnInterface) OpsClient {
+	return &opsClient{cc}
+}
+
+func (c *opsClient) ListEntryRevisions(ctx context.Context, in *ListEntryRevisionsRequest, opts ...grpc.CallOption) (*ListEntryRevisionsResponse, error) {
+	out := new(ListEntryRevisionsResponse)
+	err := c.cc.Invoke(ctx, Ops_ListEntryRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) DiffEntryRevisions(ctx context.Context, in *DiffEntryRevisionsRequest, opts ...grpc.CallOption) (*DiffEntryRevisionsResponse, error) {
+	out := new(DiffEntryRevisionsResponse)
+	err := c.cc.Invoke(ctx, Ops_DiffEntryRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) RollbackEntry(ctx context.Context, in *RollbackEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Ops_RollbackEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, Ops_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) ResignEntries(ctx context.Context, in *ResignEntriesRequest, opts ...grpc.CallOption) (*ResignEntriesResponse, error) {
+	out := new(ResignEntriesResponse)
+	err := c.cc.Invoke(ctx, Ops_ResignEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) ExportEntries(ctx context.Context, in *ExportEntriesRequest, opts ...grpc.CallOption) (Ops_ExportEntriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ops_ServiceDesc.Streams[0], Ops_ExportEntries_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &opsExportEntriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ops_ExportEntriesClient interface {
+	Recv() (*v1.SignedEntry, error)
+	grpc.ClientStream
+}
+
+type opsExportEntriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *opsExportEntriesClient) Recv() (*v1.SignedEntry, error) {
+	m := new(v1.SignedEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *opsClient) ImportEntries(ctx context.Context, opts ...grpc.CallOption) (Ops_ImportEntriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ops_ServiceDesc.Streams[1], Ops_ImportEntries_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &opsImportEntriesClient{stream}
+	return x, nil
+}
+
+type Ops_ImportEntriesClient interface {
+	Send(*ImportEntriesRequest) error
+	CloseAndRecv() (*ImportEntriesResponse, error)
+	grpc.ClientStream
+}
+
+type opsImportEntriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *opsImportEntriesClient) Send(m *ImportEntriesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *opsImportEntriesClient) CloseAndRecv() (*ImportEntriesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportEntriesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *opsClient) PlanEntries(ctx context.Context, opts ...grpc.CallOption) (Ops_PlanEntriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ops_ServiceDesc.Streams[2], Ops_PlanEntries_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &opsPlanEntriesClient{stream}
+	return x, nil
+}
+
+type Ops_PlanEntriesClient interface {
+	Send(*PlanEntriesRequest) error
+	CloseAndRecv() (*PlanEntriesResponse, error)
+	grpc.ClientStream
+}
+
+type opsPlanEntriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *opsPlanEntriesClient) Send(m *PlanEntriesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *opsPlanEntriesClient) CloseAndRecv() (*PlanEntriesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(PlanEntriesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *opsClient) SetEntryPolicy(ctx context.Context, in *SetEntryPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Ops_SetEntryPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) GetEntryPolicy(ctx context.Context, in *GetEntryPolicyRequest, opts ...grpc.CallOption) (*EntryPolicy, error) {
+	out := new(EntryPolicy)
+	err := c.cc.Invoke(ctx, Ops_GetEntryPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) DeleteEntryPolicy(ctx context.Context, in *DeleteEntryPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Ops_DeleteEntryPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) GetMemberHealthHistory(ctx context.Context, in *GetMemberHealthHistoryRequest, opts ...grpc.CallOption) (*GetMemberHealthHistoryResponse, error) {
+	out := new(GetMemberHealthHistoryResponse)
+	err := c.cc.Invoke(ctx, Ops_GetMemberHealthHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) TestHealthCheck(ctx context.Context, in *TestHealthCheckRequest, opts ...grpc.CallOption) (*TestHealthCheckResponse, error) {
+	out := new(TestHealthCheckResponse)
+	err := c.cc.Invoke(ctx, Ops_TestHealthCheck_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) RecheckMember(ctx context.Context, in *RecheckMemberRequest, opts ...grpc.CallOption) (*RecheckMemberResponse, error) {
+	out := new(RecheckMemberResponse)
+	err := c.cc.Invoke(ctx, Ops_RecheckMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) ReportMemberSignal(ctx context.Context, in *ReportMemberSignalRequest, opts ...grpc.CallOption) (*MemberSignal, error) {
+	out := new(MemberSignal)
+	err := c.cc.Invoke(ctx, Ops_ReportMemberSignal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) ClearMemberSignal(ctx context.Context, in *ClearMemberSignalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Ops_ClearMemberSignal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) ListMemberSignals(ctx context.Context, in *ListMemberSignalsRequest, opts ...grpc.CallOption) (*ListMemberSignalsResponse, error) {
+	out := new(ListMemberSignalsResponse)
+	err := c.cc.Invoke(ctx, Ops_ListMemberSignals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsClient) ListEffectiveWeights(ctx context.Context, in *ListEffectiveWeightsRequest, opts ...grpc.CallOption) (*ListEffectiveWeightsResponse, error) {
+	out := new(ListEffectiveWeightsResponse)
+	err := c.cc.Invoke(ctx, Ops_ListEffectiveWeights_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OpsServer is the server API for Ops service.
+// All implementations must embed UnimplementedOpsServer
+// for forward compatibility
+type OpsServer interface {
+	// ListEntryRevisions list kept revisions of an entry, newest first.
+	ListEntryRevisions(context.Context, *ListEntryRevisionsRequest) (*ListEntryRevisionsResponse, error)
+	// DiffEntryRevisions show differences between two revisions of an entry.
+	DiffEntryRevisions(context.Context, *DiffEntryRevisionsRequest) (*DiffEntryRevisionsResponse, error)
+	// RollbackEntry set back an entry as it was at given revision.
+	RollbackEntry(context.Context, *RollbackEntryRequest) (*emptypb.Empty, error)
+	// ListAuditEvents list audit events of api mutations recorded by the receiving node, oldest first.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// ResignEntries sign again entries with the current signing key, this is used when rotating keys.
+	ResignEntries(context.Context, *ResignEntriesRequest) (*ResignEntriesResponse, error)
+	// ExportEntries stream entries matching prefix and tags, signatures are removed.
+	ExportEntries(*ExportEntriesRequest, Ops_ExportEntriesServer) error
+	// ImportEntries receive options as first message and then entries to import.
+	ImportEntries(Ops_ImportEntriesServer) error
+	// PlanEntries receive options as first message and then desired entries,
+	// it gives changes needed to reach desired entries and apply them in a single transaction if asked.
+	PlanEntries(Ops_PlanEntriesServer) error
+	// SetEntryPolicy set policy applied by gsloc on an entry, it replaces the previous one.
+	SetEntryPolicy(context.Context, *SetEntryPolicyRequest) (*emptypb.Empty, error)
+	// GetEntryPolicy get policy of an entry, default policy is returned when none has been set.
+	GetEntryPolicy(context.Context, *GetEntryPolicyRequest) (*EntryPolicy, error)
+	// DeleteEntryPolicy remove policy of an entry, default policy is then applied.
+	DeleteEntryPolicy(context.Context, *DeleteEntryPolicyRequest) (*emptypb.Empty, error)
+	// GetMemberHealthHistory list last probes made by the receiving node on members of an entry, newest first.
+	GetMemberHealthHistory(context.Context, *GetMemberHealthHistoryRequest) (*GetMemberHealthHistoryResponse, error)
+	// TestHealthCheck runs a health check definition from the receiving node against given ips, nothing is stored.
+	TestHealthCheck(context.Context, *TestHealthCheckRequest) (*TestHealthCheckResponse, error)
+	// RecheckMember probes now a member of an entry and push result to consul, receiving node must be in member dc.
+	RecheckMember(context.Context, *RecheckMemberRequest) (*RecheckMemberResponse, error)
+	// ReportMemberSignal report a member as degraded or failed for a while, e.g. by an external monitoring,
+	// it replaces previous signal on member. Member is excluded from dns answers until signal expires.
+	ReportMemberSignal(context.Context, *ReportMemberSignalRequest) (*MemberSignal, error)
+	// ClearMemberSignal remove signal reported on a member before it expires.
+	ClearMemberSignal(context.Context, *ClearMemberSignalRequest) (*emptypb.Empty, error)
+	// ListMemberSignals list signals which are not expired.
+	ListMemberSignals(context.Context, *ListMemberSignalsRequest) (*ListMemberSignalsResponse, error)
+	// ListEffectiveWeights list weights computed by dcs for members of entries using adaptive weights,
+	// expired ones are not given.
+	ListEffectiveWeights(context.Context, *ListEffectiveWeightsRequest) (*ListEffectiveWeightsResponse, error)
+	mustEmbedUnimplementedOpsServer()
+}
+
+// UnimplementedOpsServer must be embedded to have forward compatible implementations.
+type UnimplementedOpsServer struct {
+}
+
+func (UnimplementedOpsServer) ListEntryRevisions(context.Context, *ListEntryRevisionsRequest) (*ListEntryRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntryRevisions not implemented")
+}
+func (UnimplementedOpsServer) DiffEntryRevisions(context.Context, *DiffEntryRevisionsRequest) (*DiffEntryRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffEntryRevisions not implemented")
+}
+func (UnimplementedOpsServer) RollbackEntry(context.Context, *RollbackEntryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackEntry not implemented")
+}
+func (UnimplementedOpsServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedOpsServer) ResignEntries(context.Context, *ResignEntriesRequest) (*ResignEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResignEntries not implemented")
+}
+func (UnimplementedOpsServer) ExportEntries(*ExportEntriesRequest, Ops_ExportEntriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportEntries not implemented")
+}
+func (UnimplementedOpsServer) ImportEntries(Ops_ImportEntriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportEntries not implemented")
+}
+func (UnimplementedOpsServer) PlanEntries(Ops_PlanEntriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method PlanEntries not implemented")
+}
+func (UnimplementedOpsServer) SetEntryPolicy(context.Context, *SetEntryPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEntryPolicy not implemented")
+}
+func (UnimplementedOpsServer) GetEntryPolicy(context.Context, *GetEntryPolicyRequest) (*EntryPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntryPolicy not implemented")
+}
+func (UnimplementedOpsServer) DeleteEntryPolicy(context.Context, *DeleteEntryPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntryPolicy not implemented")
+}
+func (UnimplementedOpsServer) GetMemberHealthHistory(context.Context, *GetMemberHealthHistoryRequest) (*GetMemberHealthHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberHealthHistory not implemented")
+}
+func (UnimplementedOpsServer) TestHealthCheck(context.Context, *TestHealthCheckRequest) (*TestHealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestHealthCheck not implemented")
+}
+func (UnimplementedOpsServer) RecheckMember(context.Context, *RecheckMemberRequest) (*RecheckMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecheckMember not implemented")
+}
+func (UnimplementedOpsServer) ReportMemberSignal(context.Context, *ReportMemberSignalRequest) (*MemberSignal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMemberSignal not implemented")
+}
+func (UnimplementedOpsServer) ClearMemberSignal(context.Context, *ClearMemberSignalRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearMemberSignal not implemented")
+}
+func (UnimplementedOpsServer) ListMemberSignals(context.Context, *ListMemberSignalsRequest) (*ListMemberSignalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemberSignals not implemented")
+}
+func (UnimplementedOpsServer) ListEffectiveWeights(context.Context, *ListEffectiveWeightsRequest) (*ListEffectiveWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEffectiveWeights not implemented")
+}
+func (UnimplementedOpsServer) mustEmbedUnimplementedOpsServer() {}
+
+// UnsafeOpsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OpsServer will
+// result in compilation errors.
+type UnsafeOpsServer interface {
+	mustEmbedUnimplementedOpsServer()
+}
+
+func RegisterOpsServer(s grpc.ServiceRegistrar, srv OpsServer) {
+	s.RegisterService(&Ops_ServiceDesc, srv)
+}
+
+func _Ops_ListEntryRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntryRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).ListEntryRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_ListEntryRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).ListEntryRevisions(ctx, req.(*ListEntryRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_DiffEntryRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffEntryRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).DiffEntryRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_DiffEntryRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).DiffEntryRevisions(ctx, req.(*DiffEntryRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_RollbackEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).RollbackEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_RollbackEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).RollbackEntry(ctx, req.(*RollbackEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_ResignEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResignEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).ResignEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_ResignEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).ResignEntries(ctx, req.(*ResignEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_ExportEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportEntriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OpsServer).ExportEntries(m, &opsExportEntriesServer{stream})
+}
+
+type Ops_ExportEntriesServer interface {
+	Send(*v1.SignedEntry) error
+	grpc.ServerStream
+}
+
+type opsExportEntriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *opsExportEntriesServer) Send(m *v1.SignedEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ops_ImportEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OpsServer).ImportEntries(&opsImportEntriesServer{stream})
+}
+
+type Ops_ImportEntriesServer interface {
+	SendAndClose(*ImportEntriesResponse) error
+	Recv() (*ImportEntriesRequest, error)
+	grpc.ServerStream
+}
+
+type opsImportEntriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *opsImportEntriesServer) SendAndClose(m *ImportEntriesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *opsImportEntriesServer) Recv() (*ImportEntriesRequest, error) {
+	m := new(ImportEntriesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Ops_PlanEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OpsServer).PlanEntries(&opsPlanEntriesServer{stream})
+}
+
+type Ops_PlanEntriesServer interface {
+	SendAndClose(*PlanEntriesResponse) error
+	Recv() (*PlanEntriesRequest, error)
+	grpc.ServerStream
+}
+
+type opsPlanEntriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *opsPlanEntriesServer) SendAndClose(m *PlanEntriesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *opsPlanEntriesServer) Recv() (*PlanEntriesRequest, error) {
+	m := new(PlanEntriesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Ops_SetEntryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEntryPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).SetEntryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_SetEntryPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).SetEntryPolicy(ctx, req.(*SetEntryPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_GetEntryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntryPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).GetEntryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_GetEntryPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).GetEntryPolicy(ctx, req.(*GetEntryPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_DeleteEntryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEntryPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).DeleteEntryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_DeleteEntryPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).DeleteEntryPolicy(ctx, req.(*DeleteEntryPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_GetMemberHealthHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberHealthHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).GetMemberHealthHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_GetMemberHealthHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).GetMemberHealthHistory(ctx, req.(*GetMemberHealthHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_TestHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestHealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).TestHealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_TestHealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).TestHealthCheck(ctx, req.(*TestHealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_RecheckMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecheckMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).RecheckMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_RecheckMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).RecheckMember(ctx, req.(*RecheckMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_ReportMemberSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportMemberSignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).ReportMemberSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_ReportMemberSignal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).ReportMemberSignal(ctx, req.(*ReportMemberSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_ClearMemberSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearMemberSignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).ClearMemberSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_ClearMemberSignal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).ClearMemberSignal(ctx, req.(*ClearMemberSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_ListMemberSignals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberSignalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).ListMemberSignals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_ListMemberSignals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).ListMemberSignals(ctx, req.(*ListMemberSignalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ops_ListEffectiveWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEffectiveWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServer).ListEffectiveWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ops_ListEffectiveWeights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServer).ListEffectiveWeights(ctx, req.(*ListEffectiveWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Ops_ServiceDesc is the grpc.ServiceDesc for Ops service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Ops_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gsloc.services.ops.v1.Ops",
+	HandlerType: (*OpsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListEntryRevisions",
+			Handler:    _Ops_ListEntryRevisions_Handler,
+		},
+		{
+			MethodName: "DiffEntryRevisions",
+			Handler:    _Ops_DiffEntryRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackEntry",
+			Handler:    _Ops_RollbackEntry_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Ops_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "ResignEntries",
+			Handler:    _Ops_ResignEntries_Handler,
+		},
+		{
+			MethodName: "SetEntryPolicy",
+			Handler:    _Ops_SetEntryPolicy_Handler,
+		},
+		{
+			MethodName: "GetEntryPolicy",
+			Handler:    _Ops_GetEntryPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteEntryPolicy",
+			Handler:    _Ops_DeleteEntryPolicy_Handler,
+		},
+		{
+			MethodName: "GetMemberHealthHistory",
+			Handler:    _Ops_GetMemberHealthHistory_Handler,
+		},
+		{
+			MethodName: "TestHealthCheck",
+			Handler:    _Ops_TestHealthCheck_Handler,
+		},
+		{
+			MethodName: "RecheckMember",
+			Handler:    _Ops_RecheckMember_Handler,
+		},
+		{
+			MethodName: "ReportMemberSignal",
+			Handler:    _Ops_ReportMemberSignal_Handler,
+		},
+		{
+			MethodName: "ClearMemberSignal",
+			Handler:    _Ops_ClearMemberSignal_Handler,
+		},
+		{
+			MethodName: "ListMemberSignals",
+			Handler:    _Ops_ListMemberSignals_Handler,
+		},
+		{
+			MethodName: "ListEffectiveWeights",
+			Handler:    _Ops_ListEffectiveWeights_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportEntries",
+			Handler:       _Ops_ExportEntries_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportEntries",
+			Handler:       _Ops_ImportEntries_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "PlanEntries",
+			Handler:       _Ops_PlanEntries_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "gsloc/services/ops/v1/ops.proto",
+}
@@ -5,9 +5,11 @@ import (
 	"crypto/tls"
 	"fmt"
 	consul "github.com/hashicorp/consul/api"
+	"github.com/orange-cloudfoundry/gsloc/audit"
 	"github.com/orange-cloudfoundry/gsloc/config"
 	"github.com/orange-cloudfoundry/gsloc/disco"
 	"github.com/orange-cloudfoundry/gsloc/geolocs"
+	"github.com/orange-cloudfoundry/gsloc/gslb"
 	"github.com/orange-cloudfoundry/gsloc/healthchecks"
 	"github.com/orange-cloudfoundry/gsloc/lb"
 	"github.com/orange-cloudfoundry/gsloc/proxmetrics"
@@ -15,9 +17,11 @@ import (
 	"github.com/orange-cloudfoundry/gsloc/resolvers"
 	"github.com/orange-cloudfoundry/gsloc/rets"
 	"github.com/orange-cloudfoundry/gsloc/servers"
+	"github.com/orange-cloudfoundry/gsloc/signs"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"net"
+	"net/http"
 	"net/url"
 	"os"
 	"os/signal"
@@ -48,6 +52,13 @@ type App struct {
 	hcHandler    *healthchecks.HcHandler
 	grpcServer   *grpc.Server
 	gslocConsul  *disco.GslocConsul
+	auditor      *audit.Auditor
+	signer       *signs.Signer
+	reconciler   *disco.Reconciler
+	ttlChecker   *disco.TTLChecker
+	crossDc      *disco.CrossDcProber
+	weights      *proxmetrics.WeightComputer
+	signalHook   *gslb.SignalWebhook
 	onlyServeDns bool
 	noServeDns   bool
 }
@@ -66,6 +77,10 @@ func NewApp(cnf *config.Config, onlyServeDns, noServeDns bool) (*App, error) {
 	if err != nil {
 		return nil, fmt.Errorf("app loadConsulClient: %w", err)
 	}
+	err = app.loadSigner()
+	if err != nil {
+		return nil, fmt.Errorf("app loadSigner: %w", err)
+	}
 	err = app.loadGslocConsul()
 	if err != nil {
 		return nil, fmt.Errorf("app loadGslocConsul: %w", err)
@@ -78,6 +93,10 @@ func NewApp(cnf *config.Config, onlyServeDns, noServeDns bool) (*App, error) {
 	if err != nil {
 		return nil, fmt.Errorf("app loadRetriever: %w", err)
 	}
+	err = app.loadReconciler()
+	if err != nil {
+		return nil, fmt.Errorf("app loadReconciler: %w", err)
+	}
 	err = app.loadGeoLoc()
 	if err != nil {
 		return nil, fmt.Errorf("app loadGeoLoc: %w", err)
@@ -94,6 +113,22 @@ func NewApp(cnf *config.Config, onlyServeDns, noServeDns bool) (*App, error) {
 	if err != nil {
 		return nil, fmt.Errorf("app loadHcHandler: %w", err)
 	}
+	err = app.loadTTLChecker()
+	if err != nil {
+		return nil, fmt.Errorf("app loadTTLChecker: %w", err)
+	}
+	err = app.loadCrossDcProber()
+	if err != nil {
+		return nil, fmt.Errorf("app loadCrossDcProber: %w", err)
+	}
+	err = app.loadWeightComputer()
+	if err != nil {
+		return nil, fmt.Errorf("app loadWeightComputer: %w", err)
+	}
+	err = app.loadAuditor()
+	if err != nil {
+		return nil, fmt.Errorf("app loadAuditor: %w", err)
+	}
 	err = app.loadGrpcServer()
 	if err != nil {
 		return nil, fmt.Errorf("app loadGrpcServer: %w", err)
@@ -124,6 +159,17 @@ func (a *App) loadConsulClient() error {
 	return nil
 }
 
+func (a *App) loadSigner() error {
+	a.signer = signs.NewSigner(a.cnf.Signing)
+	if a.onlyServeDns {
+		return nil
+	}
+	if !a.signer.CanSign() {
+		return fmt.Errorf("signing.sign_key_id is required to serve api")
+	}
+	return nil
+}
+
 func (a *App) loadConsulDiscoverer() error {
 	if a.onlyServeDns {
 		a.entry.Info("Only serve DNS: no consul discoverer")
@@ -132,15 +178,17 @@ func (a *App) loadConsulDiscoverer() error {
 	consulDisco := disco.NewConsulDiscoverer(
 		a.consulClient,
 		a.cnf.HealthCheckConfig.HealthcheckAuth,
+		a.cnf.HealthCheckConfig.HealthcheckAuthKey,
 		a.cnf.DcName,
 		a.cnf.HealthCheckConfig.HealthcheckAddress,
+		a.cnf.HealthCheckConfig.Mode,
 	)
 	a.consulDisco = consulDisco
 	return nil
 }
 
 func (a *App) loadRetriever() error {
-	retriever := rets.NewRetriever(a.cnf.DcName, 10, time.Duration(*a.cnf.ConsulConfig.ScrapInterval), a.consulClient)
+	retriever := rets.NewRetriever(a.cnf.DcName, 10, time.Duration(*a.cnf.ConsulConfig.ScrapInterval), a.consulClient, a.signer)
 	if a.noServeDns {
 		retriever.DisableCatalogPolling()
 	}
@@ -148,6 +196,19 @@ func (a *App) loadRetriever() error {
 	return nil
 }
 
+func (a *App) loadReconciler() error {
+	if a.onlyServeDns {
+		a.entry.Info("Only serve DNS: no reconciler")
+		return nil
+	}
+	if a.cnf.Reconciler.Disable {
+		a.entry.Info("Reconciler disabled: consul services are not reconciled with kv entries")
+		return nil
+	}
+	a.reconciler = disco.NewReconciler(a.cnf.Reconciler, a.consulDisco, a.retriever)
+	return nil
+}
+
 func (a *App) makeMetricsProxy() *proxmetrics.Fetcher {
 	rawConsul := fmt.Sprintf("%s://%s/v1/agent/metrics?format=prometheus&token=%s",
 		a.cnf.ConsulConfig.Scheme,
@@ -233,7 +294,7 @@ func (a *App) loadGSLBHandler() error {
 			IpNet: local6,
 		},
 	}
-	a.gslbHandler = resolvers.NewGSLBHandler(a.lbFactory, a.cnf.DNSServer.TrustEdns, append(allowed, a.cnf.DNSServer.AllowedInspect...))
+	a.gslbHandler = resolvers.NewGSLBHandler(a.lbFactory, a.cnf.DNSServer.TrustEdns, append(allowed, a.cnf.DNSServer.AllowedInspect...), a.retriever)
 	return nil
 }
 
@@ -242,7 +303,68 @@ func (a *App) loadHcHandler() error {
 		a.entry.Info("Only serve DNS: no healthcheck handler")
 		return nil
 	}
-	a.hcHandler = healthchecks.NewHcHandler(a.cnf.HealthCheckConfig)
+	a.hcHandler = healthchecks.NewHcHandler(a.cnf.HealthCheckConfig, a.cnf.DcName, a.retriever)
+	return nil
+}
+
+func (a *App) loadTTLChecker() error {
+	if a.onlyServeDns {
+		a.entry.Info("Only serve DNS: no ttl checker")
+		return nil
+	}
+	if a.cnf.HealthCheckConfig.Mode != config.HealthCheckModeTTL {
+		return nil
+	}
+	a.ttlChecker = disco.NewTTLChecker(a.consulClient, a.cnf.DcName, a.hcHandler)
+	return nil
+}
+
+func (a *App) loadCrossDcProber() error {
+	if a.onlyServeDns {
+		a.entry.Info("Only serve DNS: no cross dc prober")
+		return nil
+	}
+	a.crossDc = disco.NewCrossDcProber(
+		a.consulClient, a.cnf.DcName,
+		time.Duration(*a.cnf.HealthCheckConfig.CrossDcInterval),
+		a.hcHandler, a.retriever,
+	)
+	return nil
+}
+
+func (a *App) loadWeightComputer() error {
+	if a.onlyServeDns {
+		a.entry.Info("Only serve DNS: no weight computer")
+		return nil
+	}
+	fetcher := proxmetrics.NewFetcher(
+		proxmetrics.NewScraper(&tls.Config{
+			InsecureSkipVerify: true,
+		}),
+		a.cnf.MetricsConfig.ProxyMetricsConfig.Targets,
+	)
+	a.weights = proxmetrics.NewWeightComputer(
+		a.consulClient, a.cnf.DcName,
+		time.Duration(*a.cnf.MetricsConfig.AdaptiveWeightsInterval),
+		fetcher, a.retriever,
+	)
+	return nil
+}
+
+func (a *App) loadAuditor() error {
+	if a.onlyServeDns {
+		a.entry.Info("Only serve DNS: no auditor")
+		return nil
+	}
+	if a.cnf.Audit == nil {
+		a.entry.Info("No audit configured: api mutations are not audited")
+		return nil
+	}
+	auditor, err := audit.NewAuditor(a.cnf.Audit, a.gslocConsul)
+	if err != nil {
+		return fmt.Errorf("audit.NewAuditor: %w", err)
+	}
+	a.auditor = auditor
 	return nil
 }
 
@@ -252,6 +374,10 @@ func (a *App) register() error {
 	}
 	if !a.onlyServeDns {
 		regs.DefaultRegKV.Register(a.consulDisco)
+		regs.DefaultRegKV.Register(a.hcHandler)
+		if a.ttlChecker != nil {
+			regs.DefaultRegKV.Register(a.ttlChecker)
+		}
 		regs.DefaultRegMember.Register(a.hcHandler)
 	}
 	return nil
@@ -271,6 +397,41 @@ func (a *App) Run() error {
 			log.Panicf("retriever.Run: %v", err)
 		}
 	}()
+	if a.hcHandler != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.hcHandler.Run(a.ctx)
+		}()
+	}
+	if a.ttlChecker != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.ttlChecker.Run(a.ctx)
+		}()
+	}
+	if a.crossDc != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.crossDc.Run(a.ctx)
+		}()
+	}
+	if a.weights != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.weights.Run(a.ctx)
+		}()
+	}
+	if a.reconciler != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.reconciler.Run(a.ctx)
+		}()
+	}
 	if !a.noServeDns {
 		wg.Add(1)
 		go func() {
@@ -283,14 +444,38 @@ func (a *App) Run() error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
+			// in ttl mode consul does not call back health checks, endpoint is not exposed
+			hcHandler := a.hcHandler
+			if a.ttlChecker != nil {
+				hcHandler = nil
+			}
+			var signalWebhook http.Handler
+			if a.signalHook != nil {
+				signalWebhook = a.signalHook
+			}
 			grpcServer := servers.NewHTTPServer(
 				a.cnf.HTTPServer,
-				a.hcHandler, a.grpcServer,
+				hcHandler, a.grpcServer,
 				a.makeMetricsProxy(), a.makeStatusHandler(),
+				a.retriever, signalWebhook,
 			)
 			grpcServer.Run(a.ctx)
 		}()
 	}
+	if a.onlyServeDns && a.cnf.HTTPServer.TLSPem.CertPath != "" {
+		// dns only nodes serve metrics and readiness when http server is configured
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			httpServer := servers.NewHTTPServer(
+				a.cnf.HTTPServer,
+				nil, nil,
+				a.makeMetricsProxy(), nil,
+				a.retriever, nil,
+			)
+			httpServer.Run(a.ctx)
+		}()
+	}
 	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
 	s := <-sig
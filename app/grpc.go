@@ -6,7 +6,9 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	gslbsvc "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/services/gslb/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
 	"github.com/orange-cloudfoundry/gsloc/gslb"
+	"github.com/orange-cloudfoundry/gsloc/servers"
 	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
@@ -47,28 +49,36 @@ func InterceptorLogger(l log.FieldLogger) logging.Logger {
 }
 
 func (a *App) makeGrpcOptions() ([]grpc.ServerOption, error) {
-	creds, err := credentials.NewServerTLSFromFile(a.cnf.HTTPServer.TLSPem.CertPath, a.cnf.HTTPServer.TLSPem.PrivateKeyPath)
+	tlsConf, err := servers.MakeServerTLSConfig(a.cnf.HTTPServer.TLSPem)
 	if err != nil {
 		return nil, fmt.Errorf("agent: failed to load tls credentials: %v", err)
 	}
 	grpcOptions := []grpc.ServerOption{
-		grpc.Creds(creds),
+		grpc.Creds(credentials.NewTLS(tlsConf)),
 		grpc.MaxConcurrentStreams(grpcMaxConcurrentStreams),
 	}
-	grpcOptions = append(grpcOptions, grpc.ChainStreamInterceptor(
+	streamInterceptors := []grpc.StreamServerInterceptor{
 		recovery.StreamServerInterceptor(),
 		logging.StreamServerInterceptor(InterceptorLogger(log.WithField("component", "grpc_unary_server")), logging.WithLogOnEvents(logging.StartCall, logging.FinishCall)),
 		// agent server implements grpc_auth.ServiceAuthFuncOverride interface
 		//grpc_auth.StreamServerInterceptor(nullAuth),
 		otelgrpc.StreamServerInterceptor(),
-	))
-	grpcOptions = append(grpcOptions, grpc.ChainUnaryInterceptor(
+	}
+	if a.auditor != nil {
+		streamInterceptors = append(streamInterceptors, a.auditor.StreamServerInterceptor())
+	}
+	grpcOptions = append(grpcOptions, grpc.ChainStreamInterceptor(streamInterceptors...))
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		recovery.UnaryServerInterceptor(),
 		logging.UnaryServerInterceptor(InterceptorLogger(log.WithField("component", "grpc_unary_server")), logging.WithLogOnEvents(logging.StartCall, logging.FinishCall)),
 		// agent server implements grpc_auth.ServiceAuthFuncOverride interface
 		//grpc_auth.UnaryServerInterceptor(nullAuth),
 		otelgrpc.UnaryServerInterceptor(),
-	))
+	}
+	if a.auditor != nil {
+		unaryInterceptors = append(unaryInterceptors, a.auditor.UnaryServerInterceptor())
+	}
+	grpcOptions = append(grpcOptions, grpc.ChainUnaryInterceptor(unaryInterceptors...))
 	return grpcOptions, nil
 }
 
@@ -83,11 +93,15 @@ func (a *App) loadGrpcServer() error {
 	grpcServer := grpc.NewServer(grpcOptions...)
 
 	reflection.Register(grpcServer)
-	serv, err := gslb.NewServer(a.consulClient, a.gslocConsul, a.cnf.HealthCheckConfig.Plugins)
+	serv, err := gslb.NewServer(a.consulClient, a.gslocConsul, a.cnf.HealthCheckConfig.Plugins, a.cnf.History, a.cnf.Signals, a.auditor, a.signer, a.hcHandler, a.consulDisco)
 	if err != nil {
 		return fmt.Errorf("agent: failed to create gslb server: %v", err)
 	}
 	gslbsvc.RegisterGSLBServer(grpcServer, serv)
+	opssvc.RegisterOpsServer(grpcServer, serv)
+	if a.cnf.Signals.WebhookAuth != nil {
+		a.signalHook = gslb.NewSignalWebhook(serv, a.cnf.Signals.WebhookAuth, a.auditor)
+	}
 	grpc_health_v1.RegisterHealthServer(grpcServer, health.NewServer())
 	a.grpcServer = grpcServer
 	return nil
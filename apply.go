@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/entfiles"
+	"os"
+	"strings"
+)
+
+type ApplyCmd struct {
+	ClientFlags     `embed:""`
+	File            string   `short:"f" required:"" help:"yaml or json file, or directory of such files, containing desired entries" type:"path"`
+	Prefix          string   `help:"only manage entries with fqdn starting with this prefix"`
+	Tags            []string `help:"only manage entries having all these tags"`
+	DeleteUnmanaged bool     `help:"delete entries in scope which are not desired, otherwise they are only reported"`
+	DryRun          bool     `help:"only show changes without applying them"`
+}
+
+func (c *ApplyCmd) Run() error {
+	signedEnts, err := entfiles.ReadPath(c.File)
+	if err != nil {
+		return err
+	}
+
+	client, conn, err := c.makeOpsClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	stream, err := client.PlanEntries(context.Background())
+	if err != nil {
+		return fmt.Errorf("plan entries: %w", err)
+	}
+	err = stream.Send(&opssvc.PlanEntriesRequest{
+		Request: &opssvc.PlanEntriesRequest_Options{
+			Options: &opssvc.PlanOptions{
+				Prefix:          c.Prefix,
+				Tags:            c.Tags,
+				DeleteUnmanaged: c.DeleteUnmanaged,
+				Apply:           !c.DryRun,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("plan entries: %w", err)
+	}
+	for _, signedEnt := range signedEnts {
+		err = stream.Send(&opssvc.PlanEntriesRequest{
+			Request: &opssvc.PlanEntriesRequest_SignedEntry{
+				SignedEntry: signedEnt,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("plan entries: %w", err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("plan entries: %w", err)
+	}
+
+	counts := make(map[opssvc.EntryChange_Action]int)
+	for _, change := range resp.GetChanges() {
+		counts[change.GetAction()]++
+		fmt.Fprintf(os.Stdout, "# %s %s\n%s\n", strings.ToLower(change.GetAction().String()), change.GetFqdn(), change.GetDiff())
+	}
+	if len(resp.GetUnmanaged()) > 0 {
+		fmt.Fprintf(os.Stdout, "Unmanaged entries kept, use --delete-unmanaged to delete them:\n  %s\n", strings.Join(resp.GetUnmanaged(), "\n  "))
+	}
+	fmt.Fprintf(os.Stdout, "Plan: %d to create, %d to update, %d to delete.\n",
+		counts[opssvc.EntryChange_CREATE], counts[opssvc.EntryChange_UPDATE], counts[opssvc.EntryChange_DELETE],
+	)
+	if len(resp.GetFailed()) > 0 {
+		return fmt.Errorf("plan entries: changes of %s have not been applied, they may have changed meanwhile", strings.Join(resp.GetFailed(), ", "))
+	}
+	if resp.GetApplied() {
+		fmt.Fprintln(os.Stdout, "Changes applied.")
+	}
+	return nil
+}
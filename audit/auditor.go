@@ -0,0 +1,383 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"github.com/miekg/dns"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	gslbsvc "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/services/gslb/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/contexes"
+	"github.com/orange-cloudfoundry/gsloc/diffs"
+	"github.com/orange-cloudfoundry/gsloc/disco"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"strings"
+)
+
+var mutatingMethods = map[string]struct{}{
+	gslbsvc.GSLB_SetEntry_FullMethodName:         {},
+	gslbsvc.GSLB_DeleteEntry_FullMethodName:      {},
+	gslbsvc.GSLB_SetMember_FullMethodName:        {},
+	gslbsvc.GSLB_DeleteMember_FullMethodName:     {},
+	gslbsvc.GSLB_SetMembersStatus_FullMethodName: {},
+	gslbsvc.GSLB_SetHealthCheck_FullMethodName:   {},
+	opssvc.Ops_RollbackEntry_FullMethodName:      {},
+	opssvc.Ops_ResignEntries_FullMethodName:      {},
+	opssvc.Ops_ImportEntries_FullMethodName:      {},
+	opssvc.Ops_PlanEntries_FullMethodName:        {},
+	opssvc.Ops_SetEntryPolicy_FullMethodName:     {},
+	opssvc.Ops_DeleteEntryPolicy_FullMethodName:  {},
+	opssvc.Ops_RecheckMember_FullMethodName:      {},
+	opssvc.Ops_ReportMemberSignal_FullMethodName: {},
+	opssvc.Ops_ClearMemberSignal_FullMethodName:  {},
+}
+
+// defaultListLimit caps events listed when no limit is requested, memory used to read audit files stays bounded.
+const defaultListLimit = 1000
+
+// policyMethods are mutating methods changing policies of entries instead of entries themselves.
+var policyMethods = map[string]struct{}{
+	opssvc.Ops_SetEntryPolicy_FullMethodName:    {},
+	opssvc.Ops_DeleteEntryPolicy_FullMethodName: {},
+}
+
+// recordingStream keeps fqdns of entries received and the last message sent on a client stream,
+// entries in scope of the call are retrieved when options are received, before any change is made.
+type recordingStream struct {
+	grpc.ServerStream
+	auditor *Auditor
+	fqdns   []string
+	resp    any
+	// before holds entries in scope by fqdn
+	before map[string]*entries.SignedEntry
+	// readOnly is set when call only plans changes without applying them
+	readOnly bool
+}
+
+func (s *recordingStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+	switch r := m.(type) {
+	case *opssvc.ImportEntriesRequest:
+		if r.GetOptions() != nil {
+			s.retrieveBefore(r.GetOptions().GetPrefix(), r.GetOptions().GetTags())
+		}
+		if r.GetSignedEntry() != nil {
+			s.fqdns = append(s.fqdns, dns.CanonicalName(r.GetSignedEntry().GetEntry().GetFqdn()))
+		}
+	case *opssvc.PlanEntriesRequest:
+		if r.GetOptions() != nil {
+			s.readOnly = !r.GetOptions().GetApply()
+			if !s.readOnly {
+				s.retrieveBefore(r.GetOptions().GetPrefix(), r.GetOptions().GetTags())
+			}
+		}
+		if r.GetSignedEntry() != nil {
+			s.fqdns = append(s.fqdns, dns.CanonicalName(r.GetSignedEntry().GetEntry().GetFqdn()))
+		}
+	}
+	return nil
+}
+
+func (s *recordingStream) retrieveBefore(prefix string, tags []string) {
+	signedEnts, err := s.auditor.gslocConsul.ListEntries(prefix, tags)
+	if err != nil {
+		s.auditor.entry.WithError(err).Warn("unable to retrieve entries before streamed call")
+		return
+	}
+	for _, signedEnt := range signedEnts {
+		s.before[signedEnt.GetEntry().GetFqdn()] = signedEnt
+	}
+}
+
+func (s *recordingStream) SendMsg(m any) error {
+	s.resp = m
+	return s.ServerStream.SendMsg(m)
+}
+
+type fqdnRequest interface {
+	GetFqdn() string
+}
+
+// Auditor records every mutating call made on gslb api with targeted entries before and after the call.
+type Auditor struct {
+	entry       *log.Entry
+	sink        *FileSink
+	gslocConsul *disco.GslocConsul
+}
+
+func NewAuditor(cnf *config.AuditConfig, gslocConsul *disco.GslocConsul) (*Auditor, error) {
+	sink, err := NewFileSink(cnf.Path, int64(cnf.MaxSizeMb)*1024*1024, cnf.MaxBackups)
+	if err != nil {
+		return nil, err
+	}
+	return &Auditor{
+		entry:       log.WithField("component", "audit"),
+		sink:        sink,
+		gslocConsul: gslocConsul,
+	}, nil
+}
+
+func (a *Auditor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := mutatingMethods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+		fqdns, err := a.findFqdns(req)
+		if err != nil {
+			a.entry.WithError(err).Warnf("unable to find targeted entries for %s", info.FullMethod)
+		}
+		_, isPolicy := policyMethods[info.FullMethod]
+		var before map[string]*entries.SignedEntry
+		var policiesBefore map[string]*opssvc.EntryPolicy
+		if isPolicy {
+			policiesBefore = a.retrievePolicies(fqdns)
+		} else {
+			before = a.retrieveEntries(fqdns)
+		}
+
+		resp, errCall := handler(ctx, req)
+
+		var diff string
+		if isPolicy {
+			diff = a.diffPolicies(fqdns, policiesBefore, a.retrievePolicies(fqdns))
+		} else {
+			diff = a.diff(fqdns, before, a.retrieveEntries(fqdns))
+		}
+		event := &opssvc.AuditEvent{
+			Timestamp: timestamppb.Now(),
+			Caller:    contexes.GetCaller(ctx),
+			Method:    info.FullMethod,
+			Fqdns:     fqdns,
+			Members:   findMembers(req, resp),
+			Diff:      diff,
+			Success:   errCall == nil,
+		}
+		if errCall != nil {
+			event.Error = errCall.Error()
+		}
+		err = a.sink.Write(event)
+		if err != nil {
+			a.entry.WithError(err).Errorf("unable to record audit event for %s", info.FullMethod)
+		}
+		return resp, errCall
+	}
+}
+
+// StreamServerInterceptor records mutating streaming calls with targeted and deleted fqdns,
+// diff is made on entries applied by the call.
+// Plans which are not applied are not recorded.
+func (a *Auditor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, ok := mutatingMethods[info.FullMethod]; !ok {
+			return handler(srv, ss)
+		}
+		stream := &recordingStream{
+			ServerStream: ss,
+			auditor:      a,
+			fqdns:        make([]string, 0),
+			before:       make(map[string]*entries.SignedEntry),
+		}
+		errCall := handler(srv, stream)
+		if stream.readOnly {
+			return errCall
+		}
+
+		fqdns := stream.fqdns
+		applied := make([]string, 0)
+		switch resp := stream.resp.(type) {
+		case *opssvc.ImportEntriesResponse:
+			fqdns = append(fqdns, resp.GetDeleted()...)
+			applied = append(applied, resp.GetCreated()...)
+			applied = append(applied, resp.GetUpdated()...)
+			applied = append(applied, resp.GetDeleted()...)
+		case *opssvc.PlanEntriesResponse:
+			for _, change := range resp.GetChanges() {
+				if change.GetAction() == opssvc.EntryChange_DELETE {
+					fqdns = append(fqdns, change.GetFqdn())
+				}
+				if resp.GetApplied() && !lo.Contains(resp.GetFailed(), change.GetFqdn()) {
+					applied = append(applied, change.GetFqdn())
+				}
+			}
+		}
+		sort.Strings(fqdns)
+		sort.Strings(applied)
+		event := &opssvc.AuditEvent{
+			Timestamp: timestamppb.Now(),
+			Caller:    contexes.GetCaller(ss.Context()),
+			Method:    info.FullMethod,
+			Fqdns:     fqdns,
+			Members:   []string{},
+			Diff:      a.diff(applied, stream.before, a.retrieveEntries(applied)),
+			Success:   errCall == nil,
+		}
+		if errCall != nil {
+			event.Error = errCall.Error()
+		}
+		err := a.sink.Write(event)
+		if err != nil {
+			a.entry.WithError(err).Errorf("unable to record audit event for %s", info.FullMethod)
+		}
+		return errCall
+	}
+}
+
+func (a *Auditor) ListEvents(request *opssvc.ListAuditEventsRequest) ([]*opssvc.AuditEvent, error) {
+	limit := int(request.GetLimit())
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	fqdn := ""
+	if request.GetFqdn() != "" {
+		fqdn = dns.CanonicalName(request.GetFqdn())
+	}
+	events, err := a.sink.Read(func(event *opssvc.AuditEvent) bool {
+		if request.GetSince() != nil && event.GetTimestamp().AsTime().Before(request.GetSince().AsTime()) {
+			return false
+		}
+		if request.GetUntil() != nil && event.GetTimestamp().AsTime().After(request.GetUntil().AsTime()) {
+			return false
+		}
+		if fqdn == "" {
+			return true
+		}
+		for _, eventFqdn := range event.GetFqdns() {
+			if eventFqdn == fqdn {
+				return true
+			}
+		}
+		return false
+	}, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read audit events: %v", err)
+	}
+	return events, nil
+}
+
+func (a *Auditor) findFqdns(req any) ([]string, error) {
+	switch r := req.(type) {
+	case *gslbsvc.SetEntryRequest:
+		return []string{dns.CanonicalName(r.GetEntry().GetFqdn())}, nil
+	case *gslbsvc.SetMembersStatusRequest:
+		return a.listFqdns(r.GetPrefix(), r.GetTags())
+	case *opssvc.ResignEntriesRequest:
+		return a.listFqdns(r.GetPrefix(), r.GetTags())
+	case *opssvc.SetEntryPolicyRequest:
+		return []string{dns.CanonicalName(r.GetPolicy().GetFqdn())}, nil
+	case fqdnRequest:
+		return []string{dns.CanonicalName(r.GetFqdn())}, nil
+	}
+	return []string{}, nil
+}
+
+func (a *Auditor) listFqdns(prefix string, tags []string) ([]string, error) {
+	signedEnts, err := a.gslocConsul.ListEntries(prefix, tags)
+	if err != nil {
+		return []string{}, err
+	}
+	fqdns := make([]string, 0, len(signedEnts))
+	for _, signedEnt := range signedEnts {
+		fqdns = append(fqdns, signedEnt.GetEntry().GetFqdn())
+	}
+	sort.Strings(fqdns)
+	return fqdns, nil
+}
+
+func (a *Auditor) retrieveEntries(fqdns []string) map[string]*entries.SignedEntry {
+	signedEnts := make(map[string]*entries.SignedEntry)
+	for _, fqdn := range fqdns {
+		signedEntry, err := a.gslocConsul.RetrieveSignedEntry(fqdn)
+		if err != nil {
+			continue
+		}
+		signedEnts[fqdn] = signedEntry
+	}
+	return signedEnts
+}
+
+func (a *Auditor) diff(fqdns []string, before, after map[string]*entries.SignedEntry) string {
+	sb := &strings.Builder{}
+	for _, fqdn := range fqdns {
+		if before[fqdn] == nil && after[fqdn] == nil {
+			continue
+		}
+		if before[fqdn] != nil && after[fqdn] != nil && diffs.SameContent(before[fqdn], after[fqdn]) {
+			continue
+		}
+		diff, err := diffs.SignedEntries(before[fqdn], after[fqdn])
+		if err != nil {
+			a.entry.WithError(err).Warnf("unable to diff entry %s", fqdn)
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("--- %s (before)\n+++ %s (after)\n%s", fqdn, fqdn, diff))
+	}
+	return sb.String()
+}
+
+func (a *Auditor) retrievePolicies(fqdns []string) map[string]*opssvc.EntryPolicy {
+	policies := make(map[string]*opssvc.EntryPolicy)
+	for _, fqdn := range fqdns {
+		policy, err := a.gslocConsul.RetrievePolicy(fqdn)
+		if err != nil {
+			a.entry.WithError(err).Warnf("unable to retrieve policy of %s", fqdn)
+			continue
+		}
+		if policy != nil {
+			policies[fqdn] = policy
+		}
+	}
+	return policies
+}
+
+func (a *Auditor) diffPolicies(fqdns []string, before, after map[string]*opssvc.EntryPolicy) string {
+	sb := &strings.Builder{}
+	for _, fqdn := range fqdns {
+		if proto.Equal(before[fqdn], after[fqdn]) {
+			continue
+		}
+		diff, err := diffs.Policies(before[fqdn], after[fqdn])
+		if err != nil {
+			a.entry.WithError(err).Warnf("unable to diff policy of %s", fqdn)
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("--- %s policy (before)\n+++ %s policy (after)\n%s", fqdn, fqdn, diff))
+	}
+	return sb.String()
+}
+
+func findMembers(req, resp any) []string {
+	switch r := req.(type) {
+	case *gslbsvc.SetMemberRequest:
+		return []string{r.GetMember().GetIp()}
+	case *gslbsvc.DeleteMemberRequest:
+		return []string{r.GetIp()}
+	case *opssvc.RecheckMemberRequest:
+		return []string{r.GetIp()}
+	case *opssvc.ReportMemberSignalRequest:
+		return []string{r.GetIp()}
+	case *opssvc.ClearMemberSignalRequest:
+		return []string{r.GetIp()}
+	case *gslbsvc.SetMembersStatusRequest:
+		statusResp, ok := resp.(*gslbsvc.SetMembersStatusResponse)
+		if !ok {
+			return []string{}
+		}
+		members := make([]string, 0)
+		for _, info := range statusResp.GetUpdated() {
+			members = append(members, info.GetIps()...)
+		}
+		return members
+	}
+	return []string{}
+}
@@ -0,0 +1,153 @@
+package audit
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"io/fs"
+	"os"
+	"sync"
+)
+
+// maxEventSize is the maximum size of a json line read from the sink
+const maxEventSize = 10 * 1024 * 1024
+
+// FileSink writes audit events in a file as one json document per line.
+// File is rotated when it reaches max size, rotated files are suffixed by .1 (newest) to .<max backups> (oldest).
+type FileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+	mu         sync.Mutex
+}
+
+func NewFileSink(path string, maxSize int64, maxBackups int) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("open audit file: %w", err)
+	}
+	defer f.Close()
+	return &FileSink{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}, nil
+}
+
+func (s *FileSink) Write(event *opssvc.AuditEvent) error {
+	b, err := protojson.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal audit event: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err = s.rotateIfNeeded(int64(len(b) + 1))
+	if err != nil {
+		return fmt.Errorf("rotate audit file: %w", err)
+	}
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("open audit file: %w", err)
+	}
+	defer f.Close()
+	_, err = f.Write(append(b, '\n'))
+	if err != nil {
+		return fmt.Errorf("write audit event: %w", err)
+	}
+	return f.Sync()
+}
+
+func (s *FileSink) rotateIfNeeded(toWrite int64) error {
+	if s.maxSize <= 0 {
+		return nil
+	}
+	info, err := os.Stat(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Size() == 0 || info.Size()+toWrite <= s.maxSize {
+		return nil
+	}
+	err = os.Remove(s.backupPath(s.maxBackups))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	for i := s.maxBackups - 1; i >= 1; i-- {
+		err = os.Rename(s.backupPath(i), s.backupPath(i+1))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	if s.maxBackups == 0 {
+		return os.Remove(s.path)
+	}
+	return os.Rename(s.path, s.backupPath(1))
+}
+
+func (s *FileSink) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
+
+// Read gives events from rotated and current files which match filter, filter may be nil.
+// Only the limit last matching events are given when limit is positive.
+func (s *FileSink) Read(filter func(event *opssvc.AuditEvent) bool, limit int) ([]*opssvc.AuditEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	events := make([]*opssvc.AuditEvent, 0)
+	for i := s.maxBackups; i >= 0; i-- {
+		path := s.path
+		if i > 0 {
+			path = s.backupPath(i)
+		}
+		var err error
+		events, err = readEvents(path, filter, limit, events)
+		if errors.Is(err, fs.ErrNotExist) && i > 0 {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+func readEvents(path string, filter func(event *opssvc.AuditEvent) bool, limit int, events []*opssvc.AuditEvent) ([]*opssvc.AuditEvent, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return events, fmt.Errorf("open audit file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventSize)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		event := &opssvc.AuditEvent{}
+		err := protojson.Unmarshal(scanner.Bytes(), event)
+		if err != nil {
+			return events, fmt.Errorf("unmarshal audit event: %w", err)
+		}
+		if filter != nil && !filter(event) {
+			continue
+		}
+		events = append(events, event)
+		// keep memory bounded by limit instead of file size
+		if limit > 0 && len(events) > 2*limit {
+			events = append(events[:0], events[len(events)-limit:]...)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return events, fmt.Errorf("read audit file: %w", err)
+	}
+	if limit > 0 && len(events) > limit {
+		events = events[len(events)-limit:]
+	}
+	return events, nil
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"os"
+)
+
+// ClientFlags are flags shared by commands talking to gsloc api.
+type ClientFlags struct {
+	Endpoint           string `short:"e" help:"address of gsloc api" default:"127.0.0.1:8080" env:"GSLOC_ENDPOINT"`
+	CaPath             string `help:"path to ca certificate used to verify gsloc api certificate" type:"path" env:"GSLOC_CA_PATH"`
+	CertPath           string `help:"path to client certificate for mutual tls" type:"path" env:"GSLOC_CERT_PATH"`
+	KeyPath            string `help:"path to client private key for mutual tls" type:"path" env:"GSLOC_KEY_PATH"`
+	InsecureSkipVerify bool   `help:"do not verify gsloc api certificate" env:"GSLOC_INSECURE_SKIP_VERIFY"`
+}
+
+func (c *ClientFlags) makeOpsClient() (opssvc.OpsClient, *grpc.ClientConn, error) {
+	tlsConf := &tls.Config{
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.CaPath != "" {
+		b, err := os.ReadFile(c.CaPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read ca: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, nil, fmt.Errorf("no certificate found in %s", c.CaPath)
+		}
+		tlsConf.RootCAs = pool
+	}
+	if c.CertPath != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertPath, c.KeyPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("load client certificate: %w", err)
+		}
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+	conn, err := grpc.Dial(c.Endpoint, grpc.WithTransportCredentials(credentials.NewTLS(tlsConf)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to %s: %w", c.Endpoint, err)
+	}
+	return opssvc.NewOpsClient(conn), conn, nil
+}
@@ -0,0 +1,44 @@
+package config
+
+import "fmt"
+
+const (
+	defaultAuditMaxSizeMb  = 100
+	defaultAuditMaxBackups = 3
+)
+
+type AuditConfig struct {
+	Path string `yaml:"path"`
+	// MaxSizeMb is the size in megabytes from which audit file is rotated
+	MaxSizeMb int `yaml:"max_size_mb"`
+	// MaxBackups is number of rotated audit files kept, events of older files are lost
+	MaxBackups int `yaml:"max_backups"`
+}
+
+func (c *AuditConfig) init() error {
+	if c.Path == "" {
+		return fmt.Errorf("audit path is required")
+	}
+	if c.MaxSizeMb == 0 {
+		c.MaxSizeMb = defaultAuditMaxSizeMb
+	}
+	if c.MaxSizeMb < 0 {
+		return fmt.Errorf("audit max size must be positive")
+	}
+	if c.MaxBackups == 0 {
+		c.MaxBackups = defaultAuditMaxBackups
+	}
+	if c.MaxBackups < 0 {
+		return fmt.Errorf("audit max backups must be positive")
+	}
+	return nil
+}
+
+func (c *AuditConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain AuditConfig
+	err := unmarshal((*plain)(c))
+	if err != nil {
+		return err
+	}
+	return c.init()
+}
@@ -37,6 +37,11 @@ type Config struct {
 	HealthCheckConfig *HealthCheckConfig `yaml:"healthcheck_config"`
 	GeoLoc            *GeoLoc            `yaml:"geo_loc"`
 	MetricsConfig     *MetricsConfig     `yaml:"metrics"`
+	History           *HistoryConfig     `yaml:"history"`
+	Audit             *AuditConfig       `yaml:"audit"`
+	Signing           *SigningConfig     `yaml:"signing"`
+	Reconciler        *ReconcilerConfig  `yaml:"reconciler"`
+	Signals           *SignalsConfig     `yaml:"signals"`
 }
 
 func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -57,6 +62,10 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 	if c.HealthCheckConfig == nil {
 		c.HealthCheckConfig = &HealthCheckConfig{}
+		err = c.HealthCheckConfig.init()
+		if err != nil {
+			return err
+		}
 	}
 	if c.HealthCheckConfig.HealthcheckAddress == "" {
 		_, port, err := net.SplitHostPort(c.HTTPServer.Listen)
@@ -87,6 +96,27 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 			return err
 		}
 	}
+	if c.History == nil {
+		c.History = &HistoryConfig{}
+		err = c.History.init()
+		if err != nil {
+			return err
+		}
+	}
+	if c.Reconciler == nil {
+		c.Reconciler = &ReconcilerConfig{}
+		err = c.Reconciler.init()
+		if err != nil {
+			return err
+		}
+	}
+	if c.Signals == nil {
+		c.Signals = &SignalsConfig{}
+		err = c.Signals.init()
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -117,6 +147,8 @@ func (c *HTTPServerConfig) UnmarshalYAML(unmarshal func(interface{}) error) erro
 type TLSPem struct {
 	CertPath       string `yaml:"cert_path"`
 	PrivateKeyPath string `yaml:"private_key_path"`
+	// ClientCaPath is ca used to verify client certificates, when set callers giving a certificate are identified by its common name
+	ClientCaPath string `yaml:"client_ca_path"`
 }
 
 type BasicAuth struct {
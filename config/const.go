@@ -2,10 +2,20 @@ package config
 
 const (
 	ConsulKVEntriesPrefix   = "gsloc/entries/"
+	ConsulKVHistoryPrefix   = "gsloc/history/"
+	ConsulKVPoliciesPrefix  = "gsloc/policies/"
+	ConsulKVDcHealthPrefix  = "gsloc/dc_health/"
+	ConsulKVSignalsPrefix   = "gsloc/signals/"
+	ConsulKVWeightsPrefix   = "gsloc/weights/"
+	ConsulKVLocksPrefix     = "gsloc/locks/"
 	ConsulPrefixTagRatio    = "gsloc_ratio="
 	ConsulPrefixTagTag      = "gsloc_tag-"
 	ConsulPrefixTagDc       = "gsloc_dc="
 	ConsulPrefixTagDisabled = "gsloc_disabled"
 	ConsulMetaEntryKey      = "gsloc_entry"
 	ConsulMetaDcKey         = "gsloc_dc"
+	// ConsulMetaRegistrationKey holds a hash of the whole registration made by gsloc to detect any drift
+	ConsulMetaRegistrationKey = "gsloc_registration"
+	// ConsulMetaCredentialsKey holds an hmac of credentials sent by consul checks, they are not part of registration hash
+	ConsulMetaCredentialsKey = "gsloc_credentials"
 )
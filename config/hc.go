@@ -3,13 +3,86 @@ package config
 import (
 	"fmt"
 	"os/exec"
+	"time"
+)
+
+const (
+	// HealthCheckModeHTTP makes consul call gsloc back over http to run health checks
+	HealthCheckModeHTTP = "http"
+	// HealthCheckModeTTL makes gsloc schedule health checks itself and push results to consul ttl checks
+	HealthCheckModeTTL = "ttl"
+	// DnsHealthCheckPluginName is the name of the plugin health check made by gsloc itself to probe dns servers
+	DnsHealthCheckPluginName = "dns"
+	// CompositeHealthCheckPluginName is the name of the plugin health check combining several health checks
+	CompositeHealthCheckPluginName = "composite"
+	// PromQLHealthCheckPluginName is the name of the plugin health check evaluating a promql query against a prometheus endpoint
+	PromQLHealthCheckPluginName = "promql"
 )
 
 type HealthCheckConfig struct {
-	HealthcheckAddress string                     `yaml:"healthcheck_address"`
-	HealthcheckAuth    *BasicAuth                 `yaml:"healthcheck_auth"`
+	HealthcheckAddress string     `yaml:"healthcheck_address"`
+	HealthcheckAuth    *BasicAuth `yaml:"healthcheck_auth"`
+	// key of hmac published in registered services to detect change of healthcheck_auth without disclosing it,
+	// a random key is used if not set which makes checks be registered again after each restart
+	HealthcheckAuthKey string                     `yaml:"healthcheck_auth_key"`
 	AllowOnlyLocalhost bool                       `yaml:"allow_only_localhost"`
 	Plugins            []*PluginHealthCheckConfig `yaml:"plugins"`
+	Mode               string                     `yaml:"mode"`
+	// number of probe results kept in memory by member
+	ProbeHistorySize int `yaml:"probe_history_size"`
+	// directory where secrets referenced as secret:<name> in health tls policies are found
+	SecretsDir string `yaml:"secrets_dir"`
+	// interval between probes of members of other dcs for entries using cross dc quorum
+	CrossDcInterval *Duration `yaml:"cross_dc_interval"`
+	// maximum number of probes running at once on this node
+	MaxConcurrentProbes int `yaml:"max_concurrent_probes"`
+	// maximum number of probes running at once on this node against a same member ip
+	MaxConcurrentProbesPerIp int `yaml:"max_concurrent_probes_per_ip"`
+	// time a probe can wait for a slot before being rejected, rejected probes do not change member health
+	ProbeQueueTimeout *Duration `yaml:"probe_queue_timeout"`
+	// scheduled probes of ttl mode are delayed by a random time up to this value to spread bursts, disabled by default,
+	// it must stay lower than check timeouts
+	ProbeJitter *Duration `yaml:"probe_jitter"`
+	// prometheus compatible endpoints which can be queried by promql health checks
+	PromQLEndpoints []*PromQLEndpointConfig `yaml:"promql_endpoints"`
+}
+
+func (c *HealthCheckConfig) init() error {
+	if c.Mode == "" {
+		c.Mode = HealthCheckModeHTTP
+	}
+	if c.Mode != HealthCheckModeHTTP && c.Mode != HealthCheckModeTTL {
+		return fmt.Errorf("healthcheck mode must be %s or %s", HealthCheckModeHTTP, HealthCheckModeTTL)
+	}
+	if c.ProbeHistorySize <= 0 {
+		c.ProbeHistorySize = 20
+	}
+	if c.CrossDcInterval == nil {
+		dur := Duration(30 * time.Second)
+		c.CrossDcInterval = &dur
+	}
+	if c.MaxConcurrentProbes <= 0 {
+		c.MaxConcurrentProbes = 256
+	}
+	if c.MaxConcurrentProbesPerIp <= 0 {
+		c.MaxConcurrentProbesPerIp = 4
+	}
+	if c.ProbeQueueTimeout == nil || *c.ProbeQueueTimeout <= 0 {
+		dur := Duration(5 * time.Second)
+		c.ProbeQueueTimeout = &dur
+	}
+	if c.ProbeJitter == nil || *c.ProbeJitter < 0 {
+		dur := Duration(0)
+		c.ProbeJitter = &dur
+	}
+	names := make(map[string]struct{})
+	for _, endpoint := range c.PromQLEndpoints {
+		if _, ok := names[endpoint.Name]; ok {
+			return fmt.Errorf("promql endpoint %s is defined twice", endpoint.Name)
+		}
+		names[endpoint.Name] = struct{}{}
+	}
+	return nil
 }
 
 func (c *HealthCheckConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -18,7 +91,7 @@ func (c *HealthCheckConfig) UnmarshalYAML(unmarshal func(interface{}) error) err
 	if err != nil {
 		return err
 	}
-	return nil
+	return c.init()
 }
 
 type PluginHealthCheckConfig struct {
@@ -26,6 +99,10 @@ type PluginHealthCheckConfig struct {
 	Description string   `yaml:"description"`
 	Path        string   `yaml:"path"`
 	Args        []string `yaml:"args"`
+	// Persistent plugin is started once and receives checks as json lines on stdin instead of being started on each check
+	Persistent bool `yaml:"persistent"`
+	// maximum number of checks in flight for a persistent plugin
+	MaxConcurrency int `yaml:"max_concurrency"`
 }
 
 func (c *PluginHealthCheckConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -37,6 +114,9 @@ func (c *PluginHealthCheckConfig) UnmarshalYAML(unmarshal func(interface{}) erro
 	if c.Name == "" {
 		return fmt.Errorf("missing name in plugin")
 	}
+	if c.Name == DnsHealthCheckPluginName || c.Name == CompositeHealthCheckPluginName || c.Name == PromQLHealthCheckPluginName {
+		return fmt.Errorf("plugin name %s is reserved", c.Name)
+	}
 	if c.Description == "" {
 		return fmt.Errorf("missing description for plugin %s", c.Name)
 	}
@@ -47,5 +127,32 @@ func (c *PluginHealthCheckConfig) UnmarshalYAML(unmarshal func(interface{}) erro
 	if err != nil {
 		return fmt.Errorf("unable to find path %s for plugin %s", c.Path, c.Name)
 	}
+	if c.MaxConcurrency <= 0 {
+		c.MaxConcurrency = 16
+	}
+	return nil
+}
+
+type PromQLEndpointConfig struct {
+	Name string `yaml:"name"`
+	// URL of prometheus compatible http api, e.g. https://prometheus.local:9090
+	URL                string     `yaml:"url"`
+	BasicAuth          *BasicAuth `yaml:"basic_auth"`
+	BearerToken        string     `yaml:"bearer_token"`
+	InsecureSkipVerify bool       `yaml:"insecure_skip_verify"`
+}
+
+func (c *PromQLEndpointConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain PromQLEndpointConfig
+	err := unmarshal((*plain)(c))
+	if err != nil {
+		return err
+	}
+	if c.Name == "" {
+		return fmt.Errorf("missing name in promql endpoint")
+	}
+	if c.URL == "" {
+		return fmt.Errorf("missing url for promql endpoint %s", c.Name)
+	}
 	return nil
 }
@@ -0,0 +1,28 @@
+package config
+
+import "fmt"
+
+const defaultMaxRevisions = 10
+
+type HistoryConfig struct {
+	MaxRevisions int `yaml:"max_revisions"`
+}
+
+func (c *HistoryConfig) init() error {
+	if c.MaxRevisions == 0 {
+		c.MaxRevisions = defaultMaxRevisions
+	}
+	if c.MaxRevisions < 0 {
+		return fmt.Errorf("history max_revisions must be positive")
+	}
+	return nil
+}
+
+func (c *HistoryConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain HistoryConfig
+	err := unmarshal((*plain)(c))
+	if err != nil {
+		return err
+	}
+	return c.init()
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"regexp"
+	"time"
 )
 
 var validateNameForPath = regexp.MustCompile(`(\s|_)`).MatchString
@@ -11,9 +12,15 @@ type MetricsConfig struct {
 	AllowedInspect     []*CIDR             `yaml:"allowed_inspect"`
 	TrustXFF           bool                `yaml:"trust_xff"`
 	ProxyMetricsConfig *ProxyMetricsConfig `yaml:"proxy"`
+	// interval between computations of weights of entries using adaptive weights
+	AdaptiveWeightsInterval *Duration `yaml:"adaptive_weights_interval"`
 }
 
 func (u *MetricsConfig) init() error {
+	if u.AdaptiveWeightsInterval == nil || *u.AdaptiveWeightsInterval <= 0 {
+		dur := Duration(30 * time.Second)
+		u.AdaptiveWeightsInterval = &dur
+	}
 	if u.ProxyMetricsConfig == nil {
 		u.ProxyMetricsConfig = &ProxyMetricsConfig{}
 		err := u.ProxyMetricsConfig.init()
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const defaultReconcileInterval = 5 * time.Minute
+
+type ReconcilerConfig struct {
+	// Disable stops periodic reconciliation of consul services with kv entries
+	Disable  bool      `yaml:"disable"`
+	Interval *Duration `yaml:"interval"`
+	// DryRun only reports services to register or deregister without changing them
+	DryRun bool `yaml:"dry_run"`
+}
+
+func (c *ReconcilerConfig) init() error {
+	if c.Interval == nil {
+		dur := Duration(defaultReconcileInterval)
+		c.Interval = &dur
+	}
+	if *c.Interval <= 0 {
+		return fmt.Errorf("reconciler interval must be positive")
+	}
+	return nil
+}
+
+func (c *ReconcilerConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain ReconcilerConfig
+	err := unmarshal((*plain)(c))
+	if err != nil {
+		return err
+	}
+	return c.init()
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const defaultSignalMaxDuration = 24 * time.Hour
+
+type SignalsConfig struct {
+	// WebhookAuth enables http webhook to report member signals, calls must be made with these credentials
+	WebhookAuth *BasicAuth `yaml:"webhook_auth"`
+	// MaxDuration is the longest time a reported signal can apply
+	MaxDuration *Duration `yaml:"max_duration"`
+}
+
+func (c *SignalsConfig) init() error {
+	if c.MaxDuration == nil {
+		dur := Duration(defaultSignalMaxDuration)
+		c.MaxDuration = &dur
+	}
+	if *c.MaxDuration <= 0 {
+		return fmt.Errorf("signals max duration must be positive")
+	}
+	return nil
+}
+
+func (c *SignalsConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain SignalsConfig
+	err := unmarshal((*plain)(c))
+	if err != nil {
+		return err
+	}
+	return c.init()
+}
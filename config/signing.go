@@ -0,0 +1,149 @@
+package config
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+)
+
+const defaultSigningMaxClockSkew = 30 * time.Second
+
+const (
+	SigningKeyTypeHmac    = "hmac"
+	SigningKeyTypeEd25519 = "ed25519"
+)
+
+type SigningConfig struct {
+	// SignKeyId is the id of the key used to sign entries written by api, only needed on control-plane
+	SignKeyId string `yaml:"sign_key_id"`
+	// Enforce makes entries without a valid signature rejected, when false they are only reported
+	Enforce bool          `yaml:"enforce"`
+	Keys    []*SigningKey `yaml:"keys"`
+	// MaxClockSkew is how much older than the last seen one a signature of an entry can be before being rejected as stale
+	MaxClockSkew *Duration `yaml:"max_clock_skew"`
+}
+
+func (c *SigningConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain SigningConfig
+	err := unmarshal((*plain)(c))
+	if err != nil {
+		return err
+	}
+	if len(c.Keys) == 0 {
+		return fmt.Errorf("signing keys are required")
+	}
+	if c.MaxClockSkew == nil {
+		skew := Duration(defaultSigningMaxClockSkew)
+		c.MaxClockSkew = &skew
+	}
+	if *c.MaxClockSkew < 0 {
+		return fmt.Errorf("signing max clock skew must not be negative")
+	}
+	ids := make(map[string]struct{})
+	for _, key := range c.Keys {
+		if _, ok := ids[key.Id]; ok {
+			return fmt.Errorf("signing key %s is defined twice", key.Id)
+		}
+		ids[key.Id] = struct{}{}
+	}
+	if c.SignKeyId == "" {
+		return nil
+	}
+	signKey := c.FindKey(c.SignKeyId)
+	if signKey == nil {
+		return fmt.Errorf("signing key %s not found", c.SignKeyId)
+	}
+	if !signKey.CanSign() {
+		return fmt.Errorf("signing key %s can not be used to sign, private key is missing", c.SignKeyId)
+	}
+	return nil
+}
+
+func (c *SigningConfig) FindKey(id string) *SigningKey {
+	for _, key := range c.Keys {
+		if key.Id == id {
+			return key
+		}
+	}
+	return nil
+}
+
+type SigningKey struct {
+	Id   string `yaml:"id"`
+	Type string `yaml:"type"`
+	// Secret is the shared secret for hmac key type
+	Secret string `yaml:"secret"`
+	// PrivateKey is the pem encoded (pkcs8) private key for ed25519 key type
+	PrivateKey string `yaml:"private_key"`
+	// PublicKey is the pem encoded (pkix) public key for ed25519 key type
+	PublicKey string `yaml:"public_key"`
+
+	Ed25519Private ed25519.PrivateKey `yaml:"-"`
+	Ed25519Public  ed25519.PublicKey  `yaml:"-"`
+}
+
+func (c *SigningKey) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain SigningKey
+	err := unmarshal((*plain)(c))
+	if err != nil {
+		return err
+	}
+	if c.Id == "" {
+		return fmt.Errorf("signing key id is required")
+	}
+	switch c.Type {
+	case SigningKeyTypeHmac:
+		if c.Secret == "" {
+			return fmt.Errorf("secret is required for hmac signing key %s", c.Id)
+		}
+	case SigningKeyTypeEd25519:
+		return c.loadEd25519()
+	default:
+		return fmt.Errorf("signing key %s must have type %s or %s", c.Id, SigningKeyTypeHmac, SigningKeyTypeEd25519)
+	}
+	return nil
+}
+
+func (c *SigningKey) CanSign() bool {
+	return c.Secret != "" || c.Ed25519Private != nil
+}
+
+func (c *SigningKey) loadEd25519() error {
+	if c.PrivateKey == "" && c.PublicKey == "" {
+		return fmt.Errorf("private_key or public_key is required for ed25519 signing key %s", c.Id)
+	}
+	if c.PrivateKey != "" {
+		block, _ := pem.Decode([]byte(c.PrivateKey))
+		if block == nil {
+			return fmt.Errorf("invalid pem private key for signing key %s", c.Id)
+		}
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("parse private key for signing key %s: %w", c.Id, err)
+		}
+		privKey, ok := key.(ed25519.PrivateKey)
+		if !ok {
+			return fmt.Errorf("private key for signing key %s is not an ed25519 key", c.Id)
+		}
+		c.Ed25519Private = privKey
+		c.Ed25519Public = privKey.Public().(ed25519.PublicKey)
+	}
+	if c.PublicKey != "" {
+		block, _ := pem.Decode([]byte(c.PublicKey))
+		if block == nil {
+			return fmt.Errorf("invalid pem public key for signing key %s", c.Id)
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("parse public key for signing key %s: %w", c.Id, err)
+		}
+		pubKey, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return fmt.Errorf("public key for signing key %s is not an ed25519 key", c.Id)
+		}
+		c.Ed25519Public = pubKey
+	}
+	return nil
+}
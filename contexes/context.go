@@ -11,6 +11,8 @@ const (
 	DNSMsg gslocCtxKey = iota
 	RemoteAddr
 	FromLocalhost
+	Caller
+	ClientSubnetMask
 )
 
 func SetDNSMsg(ctx context.Context, msg *dns.Msg) context.Context {
@@ -36,3 +38,22 @@ func GetRemoteAddr(ctx context.Context) string {
 	}
 	return val.(string)
 }
+
+// SetClientSubnetMask sets prefix length of remote address when it is a subnet given by edns client subnet.
+func SetClientSubnetMask(ctx context.Context, mask uint8) context.Context {
+	return context.WithValue(ctx, ClientSubnetMask, mask)
+}
+
+// GetClientSubnetMask gives prefix length of remote address, false is given when remote address is not a subnet.
+func GetClientSubnetMask(ctx context.Context) (uint8, bool) {
+	val := ctx.Value(ClientSubnetMask)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint8), true
+}
+
+// SetCaller sets identity of caller when it is not known by grpc, e.g. for calls made from http.
+func SetCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, Caller, caller)
+}
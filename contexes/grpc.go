@@ -0,0 +1,32 @@
+package contexes
+
+import (
+	"context"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+const unknownCaller = "unknown"
+
+// GetCaller returns identity of the grpc caller found in context.
+// It gives common name of client certificate when it has been verified against client ca of server or remote address otherwise,
+// caller set with SetCaller takes precedence.
+func GetCaller(ctx context.Context) string {
+	if caller, ok := ctx.Value(Caller).(string); ok && caller != "" {
+		return caller
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return unknownCaller
+	}
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+		chains := tlsInfo.State.VerifiedChains
+		if len(chains) > 0 && len(chains[0]) > 0 && chains[0][0].Subject.CommonName != "" {
+			return chains[0][0].Subject.CommonName
+		}
+	}
+	if p.Addr == nil {
+		return unknownCaller
+	}
+	return p.Addr.String()
+}
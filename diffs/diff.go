@@ -0,0 +1,110 @@
+package diffs
+
+import (
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"strings"
+)
+
+// SignedEntries gives a line by line diff of json representation of two signed entries.
+// A nil signed entry is considered as an empty one.
+func SignedEntries(from, to *entries.SignedEntry) (string, error) {
+	fromLines, err := signedEntryToLines(from)
+	if err != nil {
+		return "", err
+	}
+	toLines, err := signedEntryToLines(to)
+	if err != nil {
+		return "", err
+	}
+	return Lines(fromLines, toLines), nil
+}
+
+// Policies gives a line by line diff of json representation of two entry policies.
+// A nil policy is considered as an empty one.
+func Policies(from, to *opssvc.EntryPolicy) (string, error) {
+	fromLines, err := messageToLines(from)
+	if err != nil {
+		return "", err
+	}
+	toLines, err := messageToLines(to)
+	if err != nil {
+		return "", err
+	}
+	return Lines(fromLines, toLines), nil
+}
+
+// SameContent tells if both signed entries have the same entry and healthcheck without taking care of signature.
+func SameContent(first, second *entries.SignedEntry) bool {
+	return proto.Equal(first.GetEntry(), second.GetEntry()) &&
+		proto.Equal(first.GetHealthcheck(), second.GetHealthcheck())
+}
+
+func signedEntryToLines(signedEntry *entries.SignedEntry) ([]string, error) {
+	if signedEntry == nil {
+		return []string{}, nil
+	}
+	// signature always differs between revisions, it is not interesting to show
+	toDiff := proto.Clone(signedEntry).(*entries.SignedEntry)
+	toDiff.Signature = ""
+	return messageToLines(toDiff)
+}
+
+func messageToLines(msg proto.Message) ([]string, error) {
+	if msg == nil || !msg.ProtoReflect().IsValid() {
+		return []string{}, nil
+	}
+	b, err := protojson.MarshalOptions{
+		Multiline: true,
+		Indent:    "  ",
+	}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(string(b), "\n"), nil
+}
+
+// Lines gives a line by line diff based on longest common subsequence
+func Lines(from, to []string) string {
+	lcs := make([][]int, len(from)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(to)+1)
+	}
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+				continue
+			}
+			lcs[i][j] = lcs[i+1][j]
+			if lcs[i][j+1] > lcs[i][j] {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	sb := &strings.Builder{}
+	i, j := 0, 0
+	for i < len(from) && j < len(to) {
+		switch {
+		case from[i] == to[j]:
+			sb.WriteString(" " + from[i] + "\n")
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			sb.WriteString("-" + from[i] + "\n")
+			i++
+		default:
+			sb.WriteString("+" + to[j] + "\n")
+			j++
+		}
+	}
+	for ; i < len(from); i++ {
+		sb.WriteString("-" + from[i] + "\n")
+	}
+	for ; j < len(to); j++ {
+		sb.WriteString("+" + to[j] + "\n")
+	}
+	return sb.String()
+}
@@ -1,10 +1,16 @@
 package disco
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	consul "github.com/hashicorp/consul/api"
 	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
 	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/healthchecks"
 	"github.com/orange-cloudfoundry/gsloc/observe"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -15,17 +21,32 @@ type ConsulDiscoverer struct {
 	hcAddr       string
 	dcName       string
 	headers      map[string][]string
+	headersKey   []byte
+	hcMode       string
 }
 
-func NewConsulDiscoverer(consulClient *consul.Client, basicAuth *config.BasicAuth, dcName, hcAddr string) *ConsulDiscoverer {
+// NewConsulDiscoverer makes a discoverer registering members on consul agent,
+// authKey is the key of hmac of credentials published in services, a random one is used when empty.
+func NewConsulDiscoverer(consulClient *consul.Client, basicAuth *config.BasicAuth, authKey, dcName, hcAddr, hcMode string) *ConsulDiscoverer {
 	headers := make(map[string][]string)
 	if basicAuth != nil {
 		headers["Authorization"] = []string{fmt.Sprintf("Basic %s", basicAuth.GetBasicAuth())}
 	}
+	headersKey := []byte(authKey)
+	if len(headersKey) == 0 {
+		headersKey = make([]byte, 32)
+		_, err := rand.Read(headersKey)
+		if err != nil {
+			log.WithError(err).Warning("unable to generate key of credentials hmac")
+		}
+	}
 	return &ConsulDiscoverer{
 		consulClient: consulClient,
 		hcAddr:       hcAddr,
 		dcName:       dcName,
+		headers:      headers,
+		headersKey:   headersKey,
+		hcMode:       hcMode,
 	}
 }
 
@@ -34,62 +55,149 @@ func (cd *ConsulDiscoverer) SetKVEntry(entry *entries.SignedEntry) {
 	cd.registerMembers(entry, entry.GetEntry().GetMembersIpv6())
 }
 func (cd *ConsulDiscoverer) registerMembers(entry *entries.SignedEntry, members []*entries.Member) {
-	hcBytes, err := protojson.Marshal(entry.GetHealthcheck())
+	for _, member := range members {
+		if member.GetDc() != cd.dcName {
+			continue
+		}
+		cd.registerMember(entry, member)
+	}
+}
+
+func (cd *ConsulDiscoverer) registerMember(entry *entries.SignedEntry, member *entries.Member) {
+	registration, err := cd.makeRegistration(entry, member)
 	if err != nil {
 		log.Errorf("unable to marshal healthcheck: %v", err)
 		return
 	}
-	parentTags := make([]string, 0)
-	if len(entry.GetEntry().GetTags()) > 0 {
-		for _, tag := range entry.GetEntry().GetTags() {
-			parentTags = append(parentTags, fmt.Sprintf("%s%s", config.ConsulPrefixTagTag, tag))
-		}
+	observe.EmitMember(observe.EventTypeSet, &observe.MemberFqdn{
+		Fqdn:   entry.GetEntry().GetFqdn(),
+		Member: member,
+	})
+	if cd.hcMode == config.HealthCheckModeTTL {
+		registration.Check.Status = cd.currentCheckStatus(registration.Check.CheckID)
 	}
-	for _, member := range members {
-		if member.GetDc() != cd.dcName {
-			continue
-		}
-		observe.EmitMember(observe.EventTypeSet, &observe.MemberFqdn{
-			Fqdn:   entry.GetEntry().GetFqdn(),
-			Member: member,
-		})
-		id := fmt.Sprintf("%s%s", entry.GetEntry().GetFqdn(), member.GetIp())
-		tags := append(parentTags, []string{
-			fmt.Sprintf("%s%d", config.ConsulPrefixTagRatio, member.GetRatio()),
-			fmt.Sprintf("%s%s", config.ConsulPrefixTagDc, member.GetDc()),
-		}...)
-		if member.GetDisabled() {
-			tags = append(tags, config.ConsulPrefixTagDisabled)
+	err = cd.consulClient.Agent().ServiceRegister(registration)
+	if err != nil {
+		log.WithError(err).Warning("Failed to register service")
+	}
+}
+
+func memberServiceID(fqdn string, member *entries.Member) string {
+	return fmt.Sprintf("%s%s", fqdn, member.GetIp())
+}
+
+func memberCheckID(serviceID string) string {
+	return fmt.Sprintf("service:%s", serviceID)
+}
+
+// currentCheckStatus gives status of a registered check, registering again a service
+// would reset its ttl check to critical until next ttl update otherwise.
+// A check not yet registered is critical until first probe.
+func (cd *ConsulDiscoverer) currentCheckStatus(checkID string) string {
+	checks, err := cd.consulClient.Agent().ChecksWithFilter(fmt.Sprintf("CheckID == %q", checkID))
+	if err != nil {
+		log.WithError(err).Warningf("unable to retrieve status of check %s", checkID)
+		return consul.HealthCritical
+	}
+	check, ok := checks[checkID]
+	if !ok {
+		return consul.HealthCritical
+	}
+	return check.Status
+}
+
+func (cd *ConsulDiscoverer) makeRegistration(entry *entries.SignedEntry, member *entries.Member) (*consul.AgentServiceRegistration, error) {
+	hcBytes, err := protojson.Marshal(entry.GetHealthcheck())
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]string, 0)
+	for _, tag := range entry.GetEntry().GetTags() {
+		tags = append(tags, fmt.Sprintf("%s%s", config.ConsulPrefixTagTag, tag))
+	}
+	tags = append(tags, []string{
+		fmt.Sprintf("%s%d", config.ConsulPrefixTagRatio, member.GetRatio()),
+		fmt.Sprintf("%s%s", config.ConsulPrefixTagDc, member.GetDc()),
+	}...)
+	if member.GetDisabled() {
+		tags = append(tags, config.ConsulPrefixTagDisabled)
+	}
+	serviceID := memberServiceID(entry.GetEntry().GetFqdn(), member)
+	check := &consul.AgentServiceCheck{
+		Interval:      entry.GetHealthcheck().GetInterval().AsDuration().String(),
+		Timeout:       entry.GetHealthcheck().GetTimeout().AsDuration().String(),
+		TLSSkipVerify: true,
+		HTTP:          fmt.Sprintf("%s/hc/%s/member/%s", cd.hcAddr, entry.GetEntry().GetFqdn(), member.GetIp()),
+		Method:        "POST",
+		Header:        cd.headers,
+		Body:          string(hcBytes),
+	}
+	if cd.hcMode == config.HealthCheckModeTTL {
+		check = &consul.AgentServiceCheck{
+			CheckID: memberCheckID(serviceID),
+			TTL:     ttlCheckTTL(entry.GetHealthcheck()).String(),
+			Status:  consul.HealthCritical,
+			Notes:   "updated by gsloc health check scheduler",
 		}
-		err := cd.consulClient.Agent().ServiceRegister(&consul.AgentServiceRegistration{
-			ID:   id,
-			Name: entry.GetEntry().GetFqdn(),
-			Tags: tags,
-			Meta: map[string]string{
-				config.ConsulMetaDcKey:    member.GetDc(),
-				config.ConsulMetaEntryKey: "true",
-			},
-			Address: member.GetIp(),
-			Check: &consul.AgentServiceCheck{
-				Interval:      entry.GetHealthcheck().GetInterval().AsDuration().String(),
-				Timeout:       entry.GetHealthcheck().GetTimeout().AsDuration().String(),
-				TLSSkipVerify: true,
-				HTTP:          fmt.Sprintf("%s/hc/%s/member/%s", cd.hcAddr, entry.GetEntry().GetFqdn(), member.GetIp()),
-				Method:        "POST",
-				Header:        cd.headers,
-				Body:          string(hcBytes),
-			},
-		})
+	}
+	registration := &consul.AgentServiceRegistration{
+		ID:   serviceID,
+		Name: entry.GetEntry().GetFqdn(),
+		Tags: tags,
+		Meta: map[string]string{
+			config.ConsulMetaDcKey:    member.GetDc(),
+			config.ConsulMetaEntryKey: "true",
+		},
+		Address: member.GetIp(),
+		Check:   check,
+	}
+	hash, err := registrationHash(registration)
+	if err != nil {
+		return nil, err
+	}
+	registration.Meta[config.ConsulMetaRegistrationKey] = hash
+	if len(check.Header) > 0 {
+		sum, err := headersHmac(cd.headersKey, check.Header)
 		if err != nil {
-			log.WithError(err).Warning("Failed to register service")
+			return nil, err
 		}
+		registration.Meta[config.ConsulMetaCredentialsKey] = sum
 	}
+	return registration, nil
+}
+
+// registrationHash gives a hash of the registration, status of check is not part of it as it is only its initial state
+// and headers are left to headersHmac as they hold credentials which could be brute forced from a plain hash.
+func registrationHash(registration *consul.AgentServiceRegistration) (string, error) {
+	toHash := *registration
+	if registration.Check != nil {
+		check := *registration.Check
+		check.Status = ""
+		check.Header = nil
+		toHash.Check = &check
+	}
+	b, err := json.Marshal(toHash)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// headersHmac gives a keyed hash of check headers, it changes with credentials without disclosing them.
+func headersHmac(key []byte, headers map[string][]string) (string, error) {
+	b, err := json.Marshal(headers)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(b) // nolint: errcheck
+	return hex.EncodeToString(mac.Sum(nil)[:16]), nil
 }
 
 func (cd *ConsulDiscoverer) deregisterMembers(entry *entries.SignedEntry, members []*entries.Member) {
 	for _, member := range members {
-		id := fmt.Sprintf("%s%s", entry.GetEntry().GetFqdn(), member.GetIp())
-		err := cd.consulClient.Agent().ServiceDeregister(id)
+		err := cd.consulClient.Agent().ServiceDeregister(memberServiceID(entry.GetEntry().GetFqdn(), member))
 		if err != nil {
 			log.WithError(err).Warning("Failed to deregister service")
 		}
@@ -100,3 +208,20 @@ func (cd *ConsulDiscoverer) RemoveKvEntry(entry *entries.SignedEntry) {
 	cd.deregisterMembers(entry, entry.GetEntry().GetMembersIpv4())
 	cd.deregisterMembers(entry, entry.GetEntry().GetMembersIpv6())
 }
+
+// PushMemberHealth makes consul take health of a member probed by gsloc into account now.
+// In ttl mode result is pushed to ttl check, otherwise member is registered again which makes consul run its check.
+func (cd *ConsulDiscoverer) PushMemberHealth(entry *entries.SignedEntry, member *entries.Member, result *healthchecks.ProbeResult) error {
+	if cd.hcMode == config.HealthCheckModeTTL {
+		status := consul.HealthPassing
+		if !result.Healthy {
+			status = consul.HealthCritical
+		}
+		return cd.consulClient.Agent().UpdateTTL(memberCheckID(memberServiceID(entry.GetEntry().GetFqdn(), member)), result.String(), status)
+	}
+	registration, err := cd.makeRegistration(entry, member)
+	if err != nil {
+		return err
+	}
+	return cd.consulClient.Agent().ServiceRegister(registration)
+}
@@ -0,0 +1,220 @@
+package disco
+
+import (
+	"context"
+	"errors"
+	consul "github.com/hashicorp/consul/api"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/healthchecks"
+	"github.com/orange-cloudfoundry/gsloc/rets"
+	log "github.com/sirupsen/logrus"
+	"github.com/sourcegraph/conc/pool"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"sync"
+	"time"
+)
+
+const crossDcMaxProbes = 20
+
+func dcHealthKey(fqdn, dcName string) string {
+	return config.ConsulKVDcHealthPrefix + fqdn + "/" + dcName
+}
+
+func crossDcLockKey(dcName string) string {
+	return config.ConsulKVLocksPrefix + "cross_dc/" + dcName
+}
+
+// CrossDcProber probes members of other dcs for entries using cross dc quorum and writes
+// health seen from this dc in kv, it lets dcs of members decide their health with a quorum of dcs.
+// Only one node of a dc, holding a consul session lock, probes and writes reports of the dc.
+type CrossDcProber struct {
+	entry        *log.Entry
+	consulClient *consul.Client
+	dcName       string
+	interval     time.Duration
+	hcHandler    *healthchecks.HcHandler
+	retriever    *rets.Retriever
+	// fqdns for which a report has been written by this node
+	reported map[string]struct{}
+}
+
+func NewCrossDcProber(consulClient *consul.Client, dcName string, interval time.Duration, hcHandler *healthchecks.HcHandler, retriever *rets.Retriever) *CrossDcProber {
+	return &CrossDcProber{
+		entry:        log.WithField("component", "cross_dc_prober"),
+		consulClient: consulClient,
+		dcName:       dcName,
+		interval:     interval,
+		hcHandler:    hcHandler,
+		retriever:    retriever,
+		reported:     make(map[string]struct{}),
+	}
+}
+
+func (p *CrossDcProber) Run(ctx context.Context) {
+	p.entry.Info("starting cross dc prober ...")
+	defer p.entry.Info("cross dc prober stopped")
+	lock, err := p.consulClient.LockOpts(&consul.LockOptions{
+		Key:            crossDcLockKey(p.dcName),
+		SessionName:    "gsloc cross dc prober " + p.dcName,
+		MonitorRetries: 3,
+	})
+	if err != nil {
+		p.entry.WithError(err).Error("failed to make cross dc prober lock")
+		return
+	}
+	for {
+		lostCh, err := lock.Lock(ctx.Done())
+		if err != nil {
+			p.entry.WithError(err).Error("failed to acquire cross dc prober lock")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(p.interval):
+				continue
+			}
+		}
+		if lostCh == nil {
+			// context is done
+			return
+		}
+		p.entry.Infof("elected as cross dc prober of dc %s", p.dcName)
+		p.runElected(ctx, lostCh)
+		err = lock.Unlock()
+		if err != nil && !errors.Is(err, consul.ErrLockNotHeld) {
+			p.entry.WithError(err).Warn("failed to release cross dc prober lock")
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		p.entry.Warnf("no longer cross dc prober of dc %s", p.dcName)
+	}
+}
+
+// runElected probes members of other dcs until context is done or lock is lost.
+func (p *CrossDcProber) runElected(ctx context.Context, lostCh <-chan struct{}) {
+	err := p.loadReported()
+	if err != nil {
+		p.entry.WithError(err).Warn("failed to list dc health reports made by previous prober")
+	}
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-lostCh:
+			return
+		case <-ticker.C:
+			if !p.retriever.KVSynced() {
+				continue
+			}
+			p.probeAll()
+		}
+	}
+}
+
+// loadReported finds reports of this dc written by a previous prober, they are removed
+// if entries no longer use cross dc quorum.
+func (p *CrossDcProber) loadReported() error {
+	keys, _, err := p.consulClient.KV().Keys(config.ConsulKVDcHealthPrefix, "", nil)
+	if err != nil {
+		return err
+	}
+	p.reported = make(map[string]struct{})
+	for _, key := range keys {
+		fqdn, dc, ok := strings.Cut(strings.TrimPrefix(key, config.ConsulKVDcHealthPrefix), "/")
+		if !ok || dc != p.dcName {
+			continue
+		}
+		p.reported[fqdn] = struct{}{}
+	}
+	return nil
+}
+
+func (p *CrossDcProber) probeAll() {
+	reporting := make(map[string]struct{})
+	for _, signedEntry := range p.retriever.ListEntries("") {
+		fqdn := signedEntry.GetEntry().GetFqdn()
+		if p.retriever.GetPolicy(fqdn).GetHealth().GetCrossDcQuorum() == 0 {
+			continue
+		}
+		report := p.probeEntry(signedEntry)
+		if len(report.GetMembers()) == 0 {
+			continue
+		}
+		reporting[fqdn] = struct{}{}
+		err := p.writeReport(fqdn, report)
+		if err != nil {
+			p.entry.WithError(err).Errorf("failed to write dc health report of %s", fqdn)
+		}
+	}
+	for fqdn := range p.reported {
+		if _, ok := reporting[fqdn]; ok {
+			continue
+		}
+		_, err := p.consulClient.KV().Delete(dcHealthKey(fqdn, p.dcName), nil)
+		if err != nil {
+			p.entry.WithError(err).Errorf("failed to delete dc health report of %s", fqdn)
+			reporting[fqdn] = struct{}{}
+		}
+	}
+	p.reported = reporting
+}
+
+func (p *CrossDcProber) probeEntry(signedEntry *entries.SignedEntry) *opssvc.DcHealthReport {
+	fqdn := signedEntry.GetEntry().GetFqdn()
+	members := append([]*entries.Member{}, signedEntry.GetEntry().GetMembersIpv4()...)
+	members = append(members, signedEntry.GetEntry().GetMembersIpv6()...)
+
+	report := &opssvc.DcHealthReport{
+		Dc: p.dcName,
+		// let some reports be missed before ignoring this dc
+		ExpiresAt: timestamppb.New(time.Now().Add(3 * p.interval)),
+		Members:   make([]*opssvc.DcMemberHealth, 0),
+	}
+	mu := &sync.Mutex{}
+	wp := pool.New().WithMaxGoroutines(crossDcMaxProbes)
+	for _, member := range members {
+		if member.GetDc() == p.dcName || member.GetDisabled() {
+			continue
+		}
+		ip := member.GetIp()
+		wp.Go(func() {
+			memberHealth := &opssvc.DcMemberHealth{
+				Ip: ip,
+			}
+			result, err := p.hcHandler.CheckRemote(fqdn, ip, signedEntry.GetHealthcheck())
+			if errors.Is(err, healthchecks.ErrProbeRejected) {
+				// member is not reported by this dc rather than reported as unhealthy
+				return
+			}
+			if err != nil {
+				memberHealth.Output = err.Error()
+			} else {
+				memberHealth.Healthy = result.Healthy
+				memberHealth.Output = strings.TrimPrefix(result.String(), healthchecks.OutputMarker)
+			}
+			mu.Lock()
+			report.Members = append(report.Members, memberHealth)
+			mu.Unlock()
+		})
+	}
+	wp.Wait()
+	return report
+}
+
+func (p *CrossDcProber) writeReport(fqdn string, report *opssvc.DcHealthReport) error {
+	val, err := protojson.Marshal(report)
+	if err != nil {
+		return err
+	}
+	_, err = p.consulClient.KV().Put(&consul.KVPair{
+		Key:   dcHealthKey(fqdn, p.dcName),
+		Value: val,
+	}, nil)
+	return err
+}
@@ -5,15 +5,19 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
 	gslbsvc "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/services/gslb/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
 	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/healthchecks"
 	"github.com/samber/lo"
 	log "github.com/sirupsen/logrus"
 	"github.com/sourcegraph/conc/pool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 )
 
 type GslocConsul struct {
@@ -38,13 +42,31 @@ func (c *GslocConsul) ConvertPairToSignedEntry(pair *consul.KVPair) (*entries.Si
 	return signedEntry, nil
 }
 
+// IndexedEntry is a signed entry with the consul modify index of its kv pair.
+type IndexedEntry struct {
+	SignedEntry *entries.SignedEntry
+	ModifyIndex uint64
+}
+
 func (c *GslocConsul) ListEntries(prefix string, tags []string) ([]*entries.SignedEntry, error) {
+	indexedEnts, err := c.ListIndexedEntries(prefix, tags)
+	if err != nil {
+		return nil, err
+	}
+	ents := make([]*entries.SignedEntry, len(indexedEnts))
+	for i, indexedEnt := range indexedEnts {
+		ents[i] = indexedEnt.SignedEntry
+	}
+	return ents, nil
+}
+
+func (c *GslocConsul) ListIndexedEntries(prefix string, tags []string) ([]*IndexedEntry, error) {
 	pairs, _, err := c.consulClient.KV().List(config.ConsulKVEntriesPrefix+prefix, nil)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list entries: %v", err)
 	}
 
-	ents := make([]*entries.SignedEntry, 0, len(pairs))
+	ents := make([]*IndexedEntry, 0, len(pairs))
 	for _, pair := range pairs {
 		signedEntry, err := c.ConvertPairToSignedEntry(pair)
 		if err != nil {
@@ -60,7 +82,10 @@ func (c *GslocConsul) ListEntries(prefix string, tags []string) ([]*entries.Sign
 		if !hasTag {
 			continue
 		}
-		ents = append(ents, signedEntry)
+		ents = append(ents, &IndexedEntry{
+			SignedEntry: signedEntry,
+			ModifyIndex: pair.ModifyIndex,
+		})
 	}
 	return ents, nil
 }
@@ -155,13 +180,14 @@ func (c *GslocConsul) GetEntryStatus(fqdn string) (*gslbsvc.GetEntryStatusRespon
 		}
 		var check *consul.HealthCheck
 		for _, c := range ent.Checks {
-			if c.Type == "http" {
+			// check is made by consul calling gsloc in http mode or pushed by gsloc in ttl mode
+			if c.Type == "http" || c.Type == "ttl" {
 				check = c
 				break
 			}
 		}
 		if check == nil {
-			log.Warnf("no http check found for %s", ent.Service.Address)
+			log.Warnf("no http or ttl check found for %s", ent.Service.Address)
 			continue
 		}
 		if check.Status == consul.HealthPassing {
@@ -169,6 +195,10 @@ func (c *GslocConsul) GetEntryStatus(fqdn string) (*gslbsvc.GetEntryStatusRespon
 		} else {
 			ms.Status = gslbsvc.MemberStatus_CHECK_FAILED
 			ms.FailureReason = check.Output
+			// output given by gsloc holds health counters, structured state is given by GetMemberHealthHistory
+			if i := strings.Index(check.Output, healthchecks.OutputMarker); i >= 0 {
+				ms.FailureReason = strings.TrimSpace(check.Output[i:])
+			}
 		}
 	}
 	return resp, nil
@@ -185,3 +215,53 @@ func (c *GslocConsul) RetrieveSignedEntry(fqdn string) (*entries.SignedEntry, er
 	}
 	return signedEntry, nil
 }
+
+// RetrievePolicy gives policy set on entry, nil is given when none has been set.
+func (c *GslocConsul) RetrievePolicy(fqdn string) (*opssvc.EntryPolicy, error) {
+	pair, _, err := c.consulClient.KV().Get(config.ConsulKVPoliciesPrefix+fqdn, nil)
+	if err != nil {
+		return nil, err
+	}
+	if pair == nil {
+		return nil, nil
+	}
+	policy := &opssvc.EntryPolicy{}
+	err = protojson.Unmarshal(pair.Value, policy)
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// ListEffectiveWeights gives effective weights computed by dcs which are not expired,
+// for entry fqdn or all entries if fqdn is empty.
+func (c *GslocConsul) ListEffectiveWeights(fqdn string) ([]*opssvc.EffectiveWeights, error) {
+	prefix := config.ConsulKVWeightsPrefix
+	if fqdn != "" {
+		prefix += fqdn + "/"
+	}
+	pairs, _, err := c.consulClient.KV().List(prefix, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list effective weights: %v", err)
+	}
+	now := time.Now()
+	weights := make([]*opssvc.EffectiveWeights, 0, len(pairs))
+	for _, pair := range pairs {
+		effectiveWeights := &opssvc.EffectiveWeights{}
+		err := protojson.Unmarshal(pair.Value, effectiveWeights)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal effective weights %s: %v", pair.Key, err)
+		}
+		if !effectiveWeights.GetExpiresAt().AsTime().After(now) {
+			continue
+		}
+		weights = append(weights, effectiveWeights)
+	}
+	sort.Slice(weights, func(i, j int) bool {
+		if weights[i].GetFqdn() != weights[j].GetFqdn() {
+			return weights[i].GetFqdn() < weights[j].GetFqdn()
+		}
+		return weights[i].GetDc() < weights[j].GetDc()
+	})
+	return weights, nil
+}
@@ -0,0 +1,186 @@
+package disco
+
+import (
+	"context"
+	"fmt"
+	consul "github.com/hashicorp/consul/api"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/rets"
+	log "github.com/sirupsen/logrus"
+	"sort"
+	"time"
+)
+
+type desiredMember struct {
+	entry  *entries.SignedEntry
+	member *entries.Member
+}
+
+// Reconciler periodically compares services registered on consul agent for this dc with members
+// of kv entries, it deregisters orphan services and registers missing or outdated ones.
+type Reconciler struct {
+	entry      *log.Entry
+	cnf        *config.ReconcilerConfig
+	discoverer *ConsulDiscoverer
+	retriever  *rets.Retriever
+}
+
+func NewReconciler(cnf *config.ReconcilerConfig, discoverer *ConsulDiscoverer, retriever *rets.Retriever) *Reconciler {
+	return &Reconciler{
+		entry:      log.WithField("component", "reconciler"),
+		cnf:        cnf,
+		discoverer: discoverer,
+		retriever:  retriever,
+	}
+}
+
+func (r *Reconciler) Run(ctx context.Context) {
+	r.entry.Infof("starting reconciler (dry run: %t) ...", r.cnf.DryRun)
+	ticker := time.NewTicker(time.Duration(*r.cnf.Interval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			r.entry.Info("reconciler stopped")
+			return
+		case <-ticker.C:
+			err := r.Reconcile()
+			if err != nil {
+				stats.AddReconcileError()
+				r.entry.WithError(err).Error("error while reconciling services")
+			}
+		}
+	}
+}
+
+func (r *Reconciler) Reconcile() error {
+	if !r.retriever.KVSynced() {
+		r.entry.Info("kv not synced yet, reconciliation skipped")
+		return nil
+	}
+	r.entry.Info("reconciling services ...")
+	defer r.entry.Info("reconciling services done.")
+
+	agent := r.discoverer.consulClient.Agent()
+	services, err := agent.ServicesWithFilter(fmt.Sprintf("Meta.%s == true and Meta.%s == %s",
+		config.ConsulMetaEntryKey,
+		config.ConsulMetaDcKey,
+		r.discoverer.dcName,
+	))
+	if err != nil {
+		return fmt.Errorf("error while listing agent services: %s", err)
+	}
+
+	orphans, missing, outdated, err := r.diff(services, r.retriever.ListEntries(""))
+	if err != nil {
+		return err
+	}
+	stats.SetDrift(reconcileActionDeregister, len(orphans))
+	stats.SetDrift(reconcileActionRegister, len(missing))
+	stats.SetDrift(reconcileActionUpdate, len(outdated))
+
+	for _, id := range orphans {
+		if r.cnf.DryRun {
+			r.entry.Infof("dry run: orphan service %s would be deregistered", id)
+			continue
+		}
+		r.entry.Infof("deregistering orphan service %s", id)
+		err := agent.ServiceDeregister(id)
+		if err != nil {
+			stats.AddReconcileError()
+			r.entry.WithError(err).Warningf("failed to deregister service %s", id)
+			continue
+		}
+		stats.AddReconciled(reconcileActionDeregister)
+	}
+	r.registerMembers(reconcileActionRegister, missing)
+	r.registerMembers(reconcileActionUpdate, outdated)
+	stats.SetLastReconcile()
+	return nil
+}
+
+// diff compares services registered on agent with members of entries in this dc, it gives ids of orphan services,
+// members without service and members with a service registered differently.
+func (r *Reconciler) diff(services map[string]*consul.AgentService, signedEntries []*entries.SignedEntry) ([]string, []*desiredMember, []*desiredMember, error) {
+	desired := make(map[string]*desiredMember)
+	for _, signedEntry := range signedEntries {
+		members := append([]*entries.Member{}, signedEntry.GetEntry().GetMembersIpv4()...)
+		members = append(members, signedEntry.GetEntry().GetMembersIpv6()...)
+		for _, member := range members {
+			if member.GetDc() != r.discoverer.dcName {
+				continue
+			}
+			desired[memberServiceID(signedEntry.GetEntry().GetFqdn(), member)] = &desiredMember{
+				entry:  signedEntry,
+				member: member,
+			}
+		}
+	}
+
+	orphans := make([]string, 0)
+	for id := range services {
+		if _, ok := desired[id]; !ok {
+			orphans = append(orphans, id)
+		}
+	}
+	missing := make([]*desiredMember, 0)
+	outdated := make([]*desiredMember, 0)
+	for id, dm := range desired {
+		service, ok := services[id]
+		if !ok {
+			missing = append(missing, dm)
+			continue
+		}
+		registration, err := r.discoverer.makeRegistration(dm.entry, dm.member)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("error while making registration of %s: %s", id, err)
+		}
+		if !sameRegistration(service, registration) {
+			outdated = append(outdated, dm)
+		}
+	}
+	return orphans, missing, outdated, nil
+}
+
+func (r *Reconciler) registerMembers(action string, dms []*desiredMember) {
+	for _, dm := range dms {
+		id := memberServiceID(dm.entry.GetEntry().GetFqdn(), dm.member)
+		if r.cnf.DryRun {
+			r.entry.Infof("dry run: service %s would be registered (%s)", id, action)
+			continue
+		}
+		r.entry.Infof("registering service %s (%s)", id, action)
+		r.discoverer.registerMember(dm.entry, dm.member)
+		stats.AddReconciled(action)
+	}
+}
+
+// sameRegistration tells if service is registered as wanted, hash of registration found in meta
+// covers check definition and meta which are not given back by agent as registered.
+func sameRegistration(service *consul.AgentService, registration *consul.AgentServiceRegistration) bool {
+	if service.Service != registration.Name || service.Address != registration.Address {
+		return false
+	}
+	if len(service.Meta) != len(registration.Meta) {
+		return false
+	}
+	for key, value := range registration.Meta {
+		if service.Meta[key] != value {
+			return false
+		}
+	}
+	if len(service.Tags) != len(registration.Tags) {
+		return false
+	}
+	serviceTags := append([]string{}, service.Tags...)
+	wantedTags := append([]string{}, registration.Tags...)
+	sort.Strings(serviceTags)
+	sort.Strings(wantedTags)
+	for i := range serviceTags {
+		if serviceTags[i] != wantedTags[i] {
+			return false
+		}
+	}
+	return true
+}
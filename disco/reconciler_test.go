@@ -0,0 +1,179 @@
+package disco
+
+import (
+	consul "github.com/hashicorp/consul/api"
+	"github.com/onsi/gomega"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	hcconf "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/healthchecks/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"sort"
+	"testing"
+	"time"
+)
+
+func newTestDiscoverer(password string) *ConsulDiscoverer {
+	return NewConsulDiscoverer(nil, &config.BasicAuth{
+		Username: "consul",
+		Password: password,
+	}, "hmac key", "dc1", "https://gsloc.example.com:8443", config.HealthCheckModeHTTP)
+}
+
+func testReconcileEntry(fqdn string, members ...*entries.Member) *entries.SignedEntry {
+	return &entries.SignedEntry{
+		Entry: &entries.Entry{
+			Fqdn:        fqdn,
+			MembersIpv4: members,
+		},
+		Healthcheck: &hcconf.HealthCheck{
+			Interval: durationpb.New(10 * time.Second),
+			Timeout:  durationpb.New(5 * time.Second),
+		},
+	}
+}
+
+// registeredService gives service as listed by agent once registration has been made.
+func registeredService(t *testing.T, cd *ConsulDiscoverer, signedEntry *entries.SignedEntry, member *entries.Member) *consul.AgentService {
+	t.Helper()
+	registration, err := cd.makeRegistration(signedEntry, member)
+	if err != nil {
+		t.Fatalf("make registration: %v", err)
+	}
+	return &consul.AgentService{
+		ID:      registration.ID,
+		Service: registration.Name,
+		Address: registration.Address,
+		Tags:    registration.Tags,
+		Meta:    registration.Meta,
+	}
+}
+
+func desiredIDs(dms []*desiredMember) []string {
+	ids := make([]string, len(dms))
+	for i, dm := range dms {
+		ids[i] = memberServiceID(dm.entry.GetEntry().GetFqdn(), dm.member)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestReconcilerDiff(t *testing.T) {
+	g := gomega.NewWithT(t)
+	cd := newTestDiscoverer("password")
+	r := NewReconciler(&config.ReconcilerConfig{}, cd, nil)
+
+	inSync := &entries.Member{Ip: "10.0.0.1", Dc: "dc1", Ratio: 1}
+	missing := &entries.Member{Ip: "10.0.0.2", Dc: "dc1", Ratio: 1}
+	outdated := &entries.Member{Ip: "10.0.0.3", Dc: "dc1", Ratio: 1}
+	otherDc := &entries.Member{Ip: "10.0.1.1", Dc: "dc2", Ratio: 1}
+	signedEntry := testReconcileEntry("app.example.com.", inSync, missing, outdated, otherDc)
+
+	outdatedEntry := proto.Clone(signedEntry).(*entries.SignedEntry)
+	outdatedEntry.Entry.MembersIpv4[2].Ratio = 5
+	services := map[string]*consul.AgentService{
+		"app.example.com.10.0.0.1": registeredService(t, cd, signedEntry, inSync),
+		"app.example.com.10.0.0.3": registeredService(t, cd, outdatedEntry, outdatedEntry.Entry.MembersIpv4[2]),
+		"old.example.com.10.0.0.9": registeredService(t, cd, testReconcileEntry("old.example.com."), &entries.Member{Ip: "10.0.0.9", Dc: "dc1"}),
+	}
+
+	orphans, missings, outdateds, err := r.diff(services, []*entries.SignedEntry{signedEntry})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(orphans).To(gomega.ConsistOf("old.example.com.10.0.0.9"))
+	g.Expect(desiredIDs(missings)).To(gomega.Equal([]string{"app.example.com.10.0.0.2"}))
+	g.Expect(desiredIDs(outdateds)).To(gomega.Equal([]string{"app.example.com.10.0.0.3"}))
+}
+
+func TestReconcilerDiffDetectsCheckChanges(t *testing.T) {
+	member := &entries.Member{Ip: "10.0.0.1", Dc: "dc1", Ratio: 1}
+	cases := []struct {
+		name     string
+		password string
+		alter    func(signedEntry *entries.SignedEntry)
+		outdated bool
+	}{
+		{
+			name:     "same registration",
+			password: "password",
+		},
+		{
+			name:     "check interval changed",
+			password: "password",
+			alter: func(signedEntry *entries.SignedEntry) {
+				signedEntry.Healthcheck.Interval = durationpb.New(time.Minute)
+			},
+			outdated: true,
+		},
+		{
+			name:     "tags changed",
+			password: "password",
+			alter: func(signedEntry *entries.SignedEntry) {
+				signedEntry.Entry.Tags = []string{"blue"}
+			},
+			outdated: true,
+		},
+		{
+			name:     "credentials changed",
+			password: "new password",
+			outdated: true,
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			signedEntry := testReconcileEntry("app.example.com.", member)
+			services := map[string]*consul.AgentService{
+				"app.example.com.10.0.0.1": registeredService(t, newTestDiscoverer("password"), signedEntry, member),
+			}
+
+			wanted := proto.Clone(signedEntry).(*entries.SignedEntry)
+			if c.alter != nil {
+				c.alter(wanted)
+			}
+			r := NewReconciler(&config.ReconcilerConfig{}, newTestDiscoverer(c.password), nil)
+			orphans, missings, outdateds, err := r.diff(services, []*entries.SignedEntry{wanted})
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(orphans).To(gomega.BeEmpty())
+			g.Expect(missings).To(gomega.BeEmpty())
+			if c.outdated {
+				g.Expect(outdateds).To(gomega.HaveLen(1))
+				return
+			}
+			g.Expect(outdateds).To(gomega.BeEmpty())
+		})
+	}
+}
+
+func TestRegistrationHashIgnoresCredentialsAndStatus(t *testing.T) {
+	g := gomega.NewWithT(t)
+	member := &entries.Member{Ip: "10.0.0.1", Dc: "dc1", Ratio: 1}
+	signedEntry := testReconcileEntry("app.example.com.", member)
+
+	registration, err := newTestDiscoverer("password").makeRegistration(signedEntry, member)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	otherCredentials, err := newTestDiscoverer("new password").makeRegistration(signedEntry, member)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	g.Expect(registration.Meta[config.ConsulMetaRegistrationKey]).To(gomega.Equal(otherCredentials.Meta[config.ConsulMetaRegistrationKey]))
+	g.Expect(registration.Meta[config.ConsulMetaCredentialsKey]).ToNot(gomega.Equal(otherCredentials.Meta[config.ConsulMetaCredentialsKey]))
+	auth := (&config.BasicAuth{Username: "consul", Password: "password"}).GetBasicAuth()
+	for _, value := range registration.Meta {
+		g.Expect(value).ToNot(gomega.ContainSubstring(auth))
+	}
+
+	check := &consul.AgentServiceCheck{
+		HTTP:   "https://gsloc.example.com:8443/hc/app.example.com./member/10.0.0.1",
+		Status: consul.HealthCritical,
+	}
+	hash, err := registrationHash(&consul.AgentServiceRegistration{ID: "app.example.com.10.0.0.1", Check: check})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	passing := *check
+	passing.Status = consul.HealthPassing
+	passing.Header = map[string][]string{"Authorization": {"Basic " + auth}}
+	passingHash, err := registrationHash(&consul.AgentServiceRegistration{ID: "app.example.com.10.0.0.1", Check: &passing})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(passingHash).To(gomega.Equal(hash))
+	// hash is made on a copy, headers sent by consul are kept
+	g.Expect(passing.Header).ToNot(gomega.BeEmpty())
+}
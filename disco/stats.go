@@ -0,0 +1,75 @@
+package disco
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	reconcileActionRegister   = "register"
+	reconcileActionDeregister = "deregister"
+	reconcileActionUpdate     = "update"
+)
+
+var stats = metrics{
+	reconciled: prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gsloc",
+		Subsystem: "reconciler",
+		Name:      "reconciled_services",
+		Help:      "Number of services registered, updated or deregistered by reconciler",
+	}, []string{
+		"action",
+	}),
+
+	drift: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "gsloc",
+		Subsystem: "reconciler",
+		Name:      "drift_services",
+		Help:      "Number of services found to register, update or deregister at last reconciliation",
+	}, []string{
+		"action",
+	}),
+
+	errors: prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "gsloc",
+		Subsystem: "reconciler",
+		Name:      "errors",
+		Help:      "Number of errors while reconciling services",
+	}),
+
+	lastReconcile: prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "gsloc",
+		Subsystem: "reconciler",
+		Name:      "last_reconcile_timestamp_seconds",
+		Help:      "Unix timestamp of the last reconciliation",
+	}),
+}
+
+type metrics struct {
+	reconciled    *prometheus.CounterVec
+	drift         *prometheus.GaugeVec
+	errors        prometheus.Counter
+	lastReconcile prometheus.Gauge
+}
+
+func init() {
+	prometheus.MustRegister(stats.reconciled)
+	prometheus.MustRegister(stats.drift)
+	prometheus.MustRegister(stats.errors)
+	prometheus.MustRegister(stats.lastReconcile)
+}
+
+func (m *metrics) AddReconciled(action string) {
+	m.reconciled.WithLabelValues(action).Add(1)
+}
+
+func (m *metrics) SetDrift(action string, nb int) {
+	m.drift.WithLabelValues(action).Set(float64(nb))
+}
+
+func (m *metrics) AddReconcileError() {
+	m.errors.Add(1)
+}
+
+func (m *metrics) SetLastReconcile() {
+	m.lastReconcile.SetToCurrentTime()
+}
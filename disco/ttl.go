@@ -0,0 +1,184 @@
+package disco
+
+import (
+	"context"
+	"errors"
+	consul "github.com/hashicorp/consul/api"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	hcconf "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/healthchecks/v1"
+	"github.com/orange-cloudfoundry/gsloc/healthchecks"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+const defaultTTLCheckInterval = 10 * time.Second
+
+// ttlCheckTTL gives time after which consul consider a ttl check as critical if not updated,
+// it let some updates to be missed before failing.
+func ttlCheckTTL(hcDef *hcconf.HealthCheck) time.Duration {
+	return 3*ttlCheckInterval(hcDef) + hcDef.GetTimeout().AsDuration()
+}
+
+func ttlCheckInterval(hcDef *hcconf.HealthCheck) time.Duration {
+	interval := hcDef.GetInterval().AsDuration()
+	if interval <= 0 {
+		return defaultTTLCheckInterval
+	}
+	return interval
+}
+
+type ttlLoop struct {
+	fqdn   string
+	ip     string
+	hcDef  *hcconf.HealthCheck
+	cancel context.CancelFunc
+}
+
+// TTLChecker schedules health checks of members of this dc and push results to consul ttl checks,
+// it is used instead of consul calling back gsloc over http.
+type TTLChecker struct {
+	entry        *log.Entry
+	consulClient *consul.Client
+	dcName       string
+	hcHandler    *healthchecks.HcHandler
+	ctx          context.Context
+	cancel       context.CancelFunc
+	mu           sync.Mutex
+	loops        map[string]*ttlLoop
+}
+
+func NewTTLChecker(consulClient *consul.Client, dcName string, hcHandler *healthchecks.HcHandler) *TTLChecker {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &TTLChecker{
+		entry:        log.WithField("component", "ttl_checker"),
+		consulClient: consulClient,
+		dcName:       dcName,
+		hcHandler:    hcHandler,
+		ctx:          ctx,
+		cancel:       cancel,
+		loops:        make(map[string]*ttlLoop),
+	}
+}
+
+func (t *TTLChecker) Run(ctx context.Context) {
+	t.entry.Info("starting ttl checker ...")
+	<-ctx.Done()
+	t.cancel()
+	t.entry.Info("ttl checker stopped")
+}
+
+func (t *TTLChecker) SetKVEntry(entry *entries.SignedEntry) {
+	fqdn := entry.GetEntry().GetFqdn()
+	members := append([]*entries.Member{}, entry.GetEntry().GetMembersIpv4()...)
+	members = append(members, entry.GetEntry().GetMembersIpv6()...)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	desired := make(map[string]struct{})
+	for _, member := range members {
+		if member.GetDc() != t.dcName {
+			continue
+		}
+		id := memberServiceID(fqdn, member)
+		desired[id] = struct{}{}
+		loop, ok := t.loops[id]
+		if ok && proto.Equal(loop.hcDef, entry.GetHealthcheck()) {
+			continue
+		}
+		if ok {
+			loop.cancel()
+		}
+		t.startLoop(id, fqdn, member.GetIp(), entry.GetHealthcheck())
+	}
+	for id, loop := range t.loops {
+		if loop.fqdn != fqdn {
+			continue
+		}
+		if _, ok := desired[id]; ok {
+			continue
+		}
+		loop.cancel()
+		delete(t.loops, id)
+	}
+}
+
+func (t *TTLChecker) RemoveKvEntry(entry *entries.SignedEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for id, loop := range t.loops {
+		if loop.fqdn != entry.GetEntry().GetFqdn() {
+			continue
+		}
+		loop.cancel()
+		delete(t.loops, id)
+	}
+}
+
+func (t *TTLChecker) startLoop(id, fqdn, ip string, hcDef *hcconf.HealthCheck) {
+	ctx, cancel := context.WithCancel(t.ctx)
+	t.loops[id] = &ttlLoop{
+		fqdn:   fqdn,
+		ip:     ip,
+		hcDef:  hcDef,
+		cancel: cancel,
+	}
+	go t.runLoop(ctx, id, fqdn, ip, hcDef)
+}
+
+func (t *TTLChecker) runLoop(ctx context.Context, id, fqdn, ip string, hcDef *hcconf.HealthCheck) {
+	interval := ttlCheckInterval(hcDef)
+	// spread checks over interval to not run them all at once
+	timer := time.NewTimer(time.Duration(rand.Int63n(int64(interval))))
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			t.check(id, fqdn, ip, hcDef)
+			timer.Reset(interval + t.jitter())
+		}
+	}
+}
+
+// jitter gives a random delay to spread probes made at once, e.g. when many entries are imported together.
+func (t *TTLChecker) jitter() time.Duration {
+	maxJitter := t.hcHandler.ProbeJitter()
+	if maxJitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(maxJitter)))
+}
+
+func (t *TTLChecker) check(id, fqdn, ip string, hcDef *hcconf.HealthCheck) {
+	status, output, err := t.probe(fqdn, ip, hcDef)
+	if err != nil {
+		// ttl check keeps its status, it becomes critical only if probes are rejected for too long
+		t.entry.WithError(err).Warningf("ttl check of %s not updated", id)
+		return
+	}
+	err = t.consulClient.Agent().UpdateTTL(memberCheckID(id), output, status)
+	if err != nil {
+		t.entry.WithError(err).Warningf("failed to update ttl check of %s", id)
+	}
+}
+
+func (t *TTLChecker) probe(fqdn, ip string, hcDef *hcconf.HealthCheck) (string, string, error) {
+	if t.hcHandler.IsDisabled(fqdn, ip) {
+		return consul.HealthCritical, healthchecks.ErrDisabledMember.Error(), nil
+	}
+	result, err := t.hcHandler.Check(fqdn, ip, hcDef)
+	if errors.Is(err, healthchecks.ErrProbeRejected) {
+		return "", "", err
+	}
+	if err != nil {
+		return consul.HealthCritical, err.Error(), nil
+	}
+	if !result.Healthy {
+		return consul.HealthCritical, result.String(), nil
+	}
+	return consul.HealthPassing, result.String(), nil
+}
@@ -0,0 +1,174 @@
+package entfiles
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v2"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	FormatYAML = "yaml"
+	FormatJSON = "json"
+)
+
+// FormatFromPath gives format to use for a file from its extension, yaml is used by default.
+func FormatFromPath(path string) string {
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		return FormatJSON
+	}
+	return FormatYAML
+}
+
+// ReadFile read signed entries from a yaml or json file.
+func ReadFile(path string) ([]*entries.SignedEntry, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	signedEnts, err := Unmarshal(b, FormatFromPath(path))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	return signedEnts, nil
+}
+
+// ReadPath read signed entries from a file or from all yaml and json files found recursively in a directory.
+func ReadPath(path string) ([]*entries.SignedEntry, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return ReadFile(path)
+	}
+	signedEnts := make([]*entries.SignedEntry, 0)
+	err = filepath.WalkDir(path, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(filePath)) {
+		case ".yml", ".yaml", ".json":
+		default:
+			return nil
+		}
+		fileEnts, err := ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		signedEnts = append(signedEnts, fileEnts...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return signedEnts, nil
+}
+
+// Unmarshal decode signed entries from a document which is either a list of signed entries or a single one.
+func Unmarshal(b []byte, format string) ([]*entries.SignedEntry, error) {
+	var doc any
+	switch format {
+	case FormatJSON:
+		err := json.Unmarshal(b, &doc)
+		if err != nil {
+			return nil, err
+		}
+	case FormatYAML:
+		err := yaml.Unmarshal(b, &doc)
+		if err != nil {
+			return nil, err
+		}
+		doc = normalizeYAML(doc)
+	default:
+		return nil, fmt.Errorf("unknown format %s", format)
+	}
+
+	var docEnts []any
+	switch d := doc.(type) {
+	case nil:
+		return []*entries.SignedEntry{}, nil
+	case []any:
+		docEnts = d
+	case map[string]any:
+		docEnts = []any{d}
+	default:
+		return nil, fmt.Errorf("document must be a signed entry or a list of signed entries")
+	}
+
+	signedEnts := make([]*entries.SignedEntry, len(docEnts))
+	for i, docEnt := range docEnts {
+		jsonEnt, err := json.Marshal(docEnt)
+		if err != nil {
+			return nil, err
+		}
+		signedEnt := &entries.SignedEntry{}
+		err = protojson.Unmarshal(jsonEnt, signedEnt)
+		if err != nil {
+			return nil, fmt.Errorf("signed entry %d: %w", i, err)
+		}
+		signedEnts[i] = signedEnt
+	}
+	return signedEnts, nil
+}
+
+// Write encode signed entries as a list in given format.
+func Write(w io.Writer, format string, signedEnts []*entries.SignedEntry) error {
+	docEnts := make([]any, len(signedEnts))
+	for i, signedEnt := range signedEnts {
+		jsonEnt, err := protojson.Marshal(signedEnt)
+		if err != nil {
+			return err
+		}
+		var docEnt any
+		err = json.Unmarshal(jsonEnt, &docEnt)
+		if err != nil {
+			return err
+		}
+		docEnts[i] = docEnt
+	}
+
+	var b []byte
+	var err error
+	switch format {
+	case FormatJSON:
+		b, err = json.MarshalIndent(docEnts, "", "  ")
+		b = append(b, '\n')
+	case FormatYAML:
+		b, err = yaml.Marshal(docEnts)
+	default:
+		return fmt.Errorf("unknown format %s", format)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// normalizeYAML convert maps decoded by yaml to maps with string keys which can be encoded in json.
+func normalizeYAML(v any) any {
+	switch t := v.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(t))
+		for k, val := range t {
+			m[fmt.Sprint(k)] = normalizeYAML(val)
+		}
+		return m
+	case []any:
+		for i, val := range t {
+			t[i] = normalizeYAML(val)
+		}
+		return t
+	}
+	return v
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/entfiles"
+	"io"
+	"os"
+)
+
+type ExportCmd struct {
+	ClientFlags `embed:""`
+	Output      string   `short:"o" help:"file to write entries, format is guessed from extension. Entries are written on stdout if not set" type:"path"`
+	Format      string   `help:"format of entries written (yaml or json), override format guessed from output extension" enum:",yaml,json" default:""`
+	Prefix      string   `help:"only export entries with fqdn starting with this prefix"`
+	Tags        []string `help:"only export entries having all these tags"`
+}
+
+func (c *ExportCmd) Run() error {
+	client, conn, err := c.makeOpsClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	stream, err := client.ExportEntries(context.Background(), &opssvc.ExportEntriesRequest{
+		Prefix: c.Prefix,
+		Tags:   c.Tags,
+	})
+	if err != nil {
+		return fmt.Errorf("export entries: %w", err)
+	}
+	signedEnts := make([]*entries.SignedEntry, 0)
+	for {
+		signedEnt, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("export entries: %w", err)
+		}
+		signedEnts = append(signedEnts, signedEnt)
+	}
+
+	format := c.Format
+	if format == "" {
+		format = entfiles.FormatFromPath(c.Output)
+	}
+	var w io.Writer = os.Stdout
+	if c.Output != "" {
+		f, err := os.Create(c.Output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return entfiles.Write(w, format, signedEnts)
+}
@@ -6,6 +6,7 @@ require (
 	github.com/ArthurHlt/emitter v1.1.0
 	github.com/ArthurHlt/gohc v1.0.0
 	github.com/alecthomas/kong v0.8.1
+	github.com/envoyproxy/protoc-gen-validate v1.0.4
 	github.com/gorilla/mux v1.8.1
 	github.com/grpc-ecosystem/go-grpc-middleware/v2 v2.0.1
 	github.com/hashicorp/consul/api v1.27.0
@@ -31,7 +32,6 @@ require (
 	github.com/armon/go-metrics v0.4.1 // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cespare/xxhash/v2 v2.2.0 // indirect
-	github.com/envoyproxy/protoc-gen-validate v1.0.4 // indirect
 	github.com/fatih/color v1.14.1 // indirect
 	github.com/go-logr/logr v1.4.1 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
@@ -0,0 +1,25 @@
+package gslb
+
+import (
+	"context"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ListAuditEvents(ctx context.Context, request *opssvc.ListAuditEventsRequest) (*opssvc.ListAuditEventsResponse, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+	if s.auditor == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "audit is not enabled on this node")
+	}
+	events, err := s.auditor.ListEvents(request)
+	if err != nil {
+		return nil, err
+	}
+	return &opssvc.ListAuditEventsResponse{
+		Events: events,
+	}, nil
+}
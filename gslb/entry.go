@@ -6,7 +6,7 @@ import (
 	"github.com/miekg/dns"
 	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
 	gslbsvc "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/services/gslb/v1"
-	"github.com/orange-cloudfoundry/gsloc-go-sdk/helpers"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
 	"github.com/orange-cloudfoundry/gsloc/config"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -40,7 +40,7 @@ func (s *Server) SetEntry(ctx context.Context, request *gslbsvc.SetEntryRequest)
 		Entry:       request.GetEntry(),
 		Healthcheck: request.GetHealthcheck(),
 	}
-	err = s.setSignedEntry(signedEntry)
+	err = s.setSignedEntry(ctx, signedEntry)
 	if err != nil {
 		return nil, err
 	}
@@ -62,22 +62,18 @@ func (s *Server) GetEntryStatus(ctx context.Context, req *gslbsvc.GetEntryStatus
 	return s.gslocConsul.GetEntryStatus(fqdn)
 }
 
-func (s *Server) setSignedEntry(entry *entries.SignedEntry) error {
-	sig, err := helpers.MessageSignature(entry)
-	if err != nil {
-		return status.Errorf(codes.Internal, "failed to sign entry: %v", err)
-	}
-	entry.Signature = sig
-
-	val, err := protojson.Marshal(entry)
-	if err != nil {
-		return status.Errorf(codes.Internal, "failed to marshal entry: %v", err)
-	}
-
-	_, err = s.consulClient.KV().Put(&consul.KVPair{
-		Key:   config.ConsulKVEntriesPrefix + entry.GetEntry().GetFqdn(),
-		Value: val,
-	}, nil)
+func (s *Server) setSignedEntry(ctx context.Context, entry *entries.SignedEntry) error {
+	err := s.commitTxBuilders(func() (consul.TxnOps, error) {
+		txOp, err := s.makeTxEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		historyOps, err := s.makeHistoryTxOps(ctx, entry, opssvc.EntryRevision_SET, 0)
+		if err != nil {
+			return nil, err
+		}
+		return append(consul.TxnOps{txOp}, historyOps...), nil
+	})
 	if err != nil {
 		return status.Errorf(codes.Internal, "failed to write entry: %v", err)
 	}
@@ -85,11 +81,10 @@ func (s *Server) setSignedEntry(entry *entries.SignedEntry) error {
 }
 
 func (s *Server) makeTxEntry(entry *entries.SignedEntry) (*consul.TxnOp, error) {
-	sig, err := helpers.MessageSignature(entry)
+	err := s.signer.Sign(entry)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to sign entry: %v", err)
 	}
-	entry.Signature = sig
 
 	val, err := protojson.Marshal(entry)
 	if err != nil {
@@ -111,7 +106,55 @@ func (s *Server) DeleteEntry(ctx context.Context, request *gslbsvc.DeleteEntryRe
 	}
 
 	fqdn := dns.CanonicalName(request.GetFqdn())
-	_, err = s.consulClient.KV().Delete(config.ConsulKVEntriesPrefix+fqdn, nil)
+	pair, _, err := s.consulClient.KV().Get(config.ConsulKVEntriesPrefix+fqdn, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get entry: %v", err)
+	}
+	if pair == nil {
+		return &emptypb.Empty{}, nil
+	}
+	signedEntry, err := s.gslocConsul.ConvertPairToSignedEntry(pair)
+	if err != nil {
+		return nil, err
+	}
+	err = s.commitTxBuilders(func() (consul.TxnOps, error) {
+		historyOps, err := s.makeHistoryTxOps(ctx, signedEntry, opssvc.EntryRevision_DELETE, 0)
+		if err != nil {
+			return nil, err
+		}
+		return append(consul.TxnOps{
+			{
+				KV: &consul.KVTxnOp{
+					Verb: consul.KVDelete,
+					Key:  config.ConsulKVEntriesPrefix + fqdn,
+				},
+			},
+			{
+				KV: &consul.KVTxnOp{
+					Verb: consul.KVDelete,
+					Key:  config.ConsulKVPoliciesPrefix + fqdn,
+				},
+			},
+			{
+				KV: &consul.KVTxnOp{
+					Verb: consul.KVDeleteTree,
+					Key:  config.ConsulKVDcHealthPrefix + fqdn + "/",
+				},
+			},
+			{
+				KV: &consul.KVTxnOp{
+					Verb: consul.KVDeleteTree,
+					Key:  config.ConsulKVSignalsPrefix + fqdn + "/",
+				},
+			},
+			{
+				KV: &consul.KVTxnOp{
+					Verb: consul.KVDeleteTree,
+					Key:  config.ConsulKVWeightsPrefix + fqdn + "/",
+				},
+			},
+		}, historyOps...), nil
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete entry: %v", err)
 	}
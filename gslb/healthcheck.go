@@ -5,6 +5,8 @@ import (
 	"github.com/miekg/dns"
 	hcconf "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/healthchecks/v1"
 	gslbsvc "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/services/gslb/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/healthchecks"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -28,7 +30,7 @@ func (s *Server) SetHealthCheck(ctx context.Context, request *gslbsvc.SetHealthC
 	}
 
 	signedEntry.Healthcheck = request.GetHealthcheck()
-	err = s.setSignedEntry(signedEntry)
+	err = s.setSignedEntry(ctx, signedEntry)
 	if err != nil {
 		return nil, err
 	}
@@ -46,11 +48,6 @@ func (s *Server) GetHealthCheck(ctx context.Context, request *gslbsvc.GetHealthC
 	if err != nil {
 		return nil, err
 	}
-
-	err = s.setSignedEntry(signedEntry)
-	if err != nil {
-		return nil, err
-	}
 	return &gslbsvc.GetHealthCheckResponse{
 		Healthcheck: signedEntry.Healthcheck,
 	}, nil
@@ -63,6 +60,39 @@ func (s *Server) validatePluginHealthCheck(healthcheck *hcconf.HealthCheck) erro
 	if healthcheck.GetPluginHealthCheck() == nil {
 		return nil
 	}
+	if healthcheck.GetPluginHealthCheck().GetName() == config.DnsHealthCheckPluginName {
+		// entry fqdn is only used as default query name which is always valid
+		_, err := healthchecks.MakeDnsOpt(healthcheck.GetPluginHealthCheck().GetOptions().AsMap(), ".")
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid dns health check: %v", err)
+		}
+		return nil
+	}
+	if healthcheck.GetPluginHealthCheck().GetName() == config.PromQLHealthCheckPluginName {
+		promQLOpt, err := healthchecks.MakePromQLOpt(healthcheck.GetPluginHealthCheck().GetOptions().AsMap())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid promql health check: %v", err)
+		}
+		// checker is only made to find endpoint, it is not run
+		_, err = healthchecks.NewPromQLHealthCheck(promQLOpt, ".", s.hcHandler.PromQLEndpoints(), nil)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid promql health check: %v", err)
+		}
+		return nil
+	}
+	if healthcheck.GetPluginHealthCheck().GetName() == config.CompositeHealthCheckPluginName {
+		compositeDef, err := healthchecks.ParseCompositeDef(healthcheck.GetPluginHealthCheck().GetOptions().AsMap())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid composite health check: %v", err)
+		}
+		for _, subCheck := range compositeDef.Checks {
+			err := s.validatePluginHealthCheck(subCheck)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	for _, plugin := range s.hcPlugins {
 		if plugin.Name == healthcheck.GetPluginHealthCheck().GetName() {
 			return nil
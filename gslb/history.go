@@ -0,0 +1,244 @@
+package gslb
+
+import (
+	"context"
+	"fmt"
+	consul "github.com/hashicorp/consul/api"
+	"github.com/miekg/dns"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/contexes"
+	"github.com/orange-cloudfoundry/gsloc/diffs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"path"
+	"sort"
+	"strconv"
+)
+
+// maxPruneOps avoid to exceed consul transaction limit when max revisions has been lowered
+const maxPruneOps = maxTransactions / 2
+
+func historyPrefix(fqdn string) string {
+	return config.ConsulKVHistoryPrefix + fqdn + "/"
+}
+
+func historyKey(fqdn string, revision uint64) string {
+	return fmt.Sprintf("%s%020d", historyPrefix(fqdn), revision)
+}
+
+func (s *Server) ListEntryRevisions(ctx context.Context, request *opssvc.ListEntryRevisionsRequest) (*opssvc.ListEntryRevisionsResponse, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	fqdn := dns.CanonicalName(request.GetFqdn())
+	pairs, _, err := s.consulClient.KV().List(historyPrefix(fqdn), nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list revisions: %v", err)
+	}
+	revisions := make([]*opssvc.EntryRevision, 0, len(pairs))
+	for _, pair := range pairs {
+		revision, err := convertPairToRevision(pair)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].GetRevision() > revisions[j].GetRevision()
+	})
+	return &opssvc.ListEntryRevisionsResponse{
+		Revisions: revisions,
+	}, nil
+}
+
+func (s *Server) DiffEntryRevisions(ctx context.Context, request *opssvc.DiffEntryRevisionsRequest) (*opssvc.DiffEntryRevisionsResponse, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	fqdn := dns.CanonicalName(request.GetFqdn())
+	from, err := s.retrieveRevision(fqdn, request.GetFromRevision())
+	if err != nil {
+		return nil, err
+	}
+	var to *opssvc.EntryRevision
+	if request.GetToRevision() > 0 {
+		to, err = s.retrieveRevision(fqdn, request.GetToRevision())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		signedEntry, err := s.gslocConsul.RetrieveSignedEntry(fqdn)
+		if err != nil {
+			return nil, err
+		}
+		to = &opssvc.EntryRevision{
+			SignedEntry: signedEntry,
+		}
+	}
+
+	diff, err := diffs.SignedEntries(from.GetSignedEntry(), to.GetSignedEntry())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to diff revisions: %v", err)
+	}
+	toName := "current"
+	if to.GetRevision() > 0 {
+		toName = fmt.Sprintf("revision %d", to.GetRevision())
+	}
+	return &opssvc.DiffEntryRevisionsResponse{
+		Diff: fmt.Sprintf("--- revision %d\n+++ %s\n%s", from.GetRevision(), toName, diff),
+		From: from,
+		To:   to,
+	}, nil
+}
+
+func (s *Server) RollbackEntry(ctx context.Context, request *opssvc.RollbackEntryRequest) (*emptypb.Empty, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	fqdn := dns.CanonicalName(request.GetFqdn())
+	revision, err := s.retrieveRevision(fqdn, request.GetRevision())
+	if err != nil {
+		return nil, err
+	}
+	signedEntry := revision.GetSignedEntry()
+	err = s.validatePluginHealthCheck(signedEntry.GetHealthcheck())
+	if err != nil {
+		return nil, err
+	}
+
+	pair, _, err := s.consulClient.KV().Get(config.ConsulKVEntriesPrefix+fqdn, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get entry: %v", err)
+	}
+	// ensure entry has not been modified between our read and the rollback
+	checkOp := &consul.TxnOp{
+		KV: &consul.KVTxnOp{
+			Verb: consul.KVCheckNotExists,
+			Key:  config.ConsulKVEntriesPrefix + fqdn,
+		},
+	}
+	if pair != nil {
+		checkOp.KV.Verb = consul.KVCheckIndex
+		checkOp.KV.Index = pair.ModifyIndex
+	}
+
+	err = s.commitTxBuilders(func() (consul.TxnOps, error) {
+		txOp, err := s.makeTxEntry(signedEntry)
+		if err != nil {
+			return nil, err
+		}
+		historyOps, err := s.makeHistoryTxOps(ctx, signedEntry, opssvc.EntryRevision_ROLLBACK, revision.GetRevision())
+		if err != nil {
+			return nil, err
+		}
+		return append(consul.TxnOps{checkOp, txOp}, historyOps...), nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "failed to rollback entry: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) retrieveRevision(fqdn string, revision uint64) (*opssvc.EntryRevision, error) {
+	pair, _, err := s.consulClient.KV().Get(historyKey(fqdn, revision), nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get revision: %v", err)
+	}
+	if pair == nil {
+		return nil, status.Errorf(codes.NotFound, "revision %d not found for %s", revision, fqdn)
+	}
+	return convertPairToRevision(pair)
+}
+
+// makeHistoryTxOps gives transaction operations to record a new revision of entry
+// and remove the oldest revisions above the configured limit.
+// Nothing is recorded when entry is set without any change since last revision.
+func (s *Server) makeHistoryTxOps(ctx context.Context, entry *entries.SignedEntry, action opssvc.EntryRevision_Action, rollbackFrom uint64) (consul.TxnOps, error) {
+	fqdn := entry.GetEntry().GetFqdn()
+	keys, _, err := s.consulClient.KV().Keys(historyPrefix(fqdn), "", nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list revisions: %v", err)
+	}
+	revisions := make([]uint64, 0, len(keys))
+	for _, key := range keys {
+		revision, err := strconv.ParseUint(path.Base(key), 10, 64)
+		if err != nil {
+			continue
+		}
+		revisions = append(revisions, revision)
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i] < revisions[j]
+	})
+
+	var lastRevision uint64
+	if len(revisions) > 0 {
+		lastRevision = revisions[len(revisions)-1]
+		if action == opssvc.EntryRevision_SET {
+			last, err := s.retrieveRevision(fqdn, lastRevision)
+			if err != nil {
+				return nil, err
+			}
+			if last.GetAction() != opssvc.EntryRevision_DELETE && diffs.SameContent(last.GetSignedEntry(), entry) {
+				return consul.TxnOps{}, nil
+			}
+		}
+	}
+
+	val, err := protojson.Marshal(&opssvc.EntryRevision{
+		Revision:     lastRevision + 1,
+		Author:       contexes.GetCaller(ctx),
+		CreatedAt:    timestamppb.Now(),
+		Action:       action,
+		SignedEntry:  entry,
+		RollbackFrom: rollbackFrom,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal revision: %v", err)
+	}
+	txOps := consul.TxnOps{
+		{
+			// revision is only created if not recorded meanwhile by a concurrent writer
+			KV: &consul.KVTxnOp{
+				Verb:  consul.KVCAS,
+				Key:   historyKey(fqdn, lastRevision+1),
+				Value: val,
+				Index: 0,
+			},
+		},
+	}
+
+	nbToPrune := len(revisions) + 1 - s.historyCnf.MaxRevisions
+	if nbToPrune > maxPruneOps {
+		nbToPrune = maxPruneOps
+	}
+	for i := 0; i < nbToPrune; i++ {
+		txOps = append(txOps, &consul.TxnOp{
+			KV: &consul.KVTxnOp{
+				Verb: consul.KVDelete,
+				Key:  historyKey(fqdn, revisions[i]),
+			},
+		})
+	}
+	return txOps, nil
+}
+
+func convertPairToRevision(pair *consul.KVPair) (*opssvc.EntryRevision, error) {
+	revision := &opssvc.EntryRevision{}
+	err := protojson.Unmarshal(pair.Value, revision)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal revision: %v", err)
+	}
+	return revision, nil
+}
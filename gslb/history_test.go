@@ -0,0 +1,367 @@
+package gslb
+
+import (
+	"context"
+	"encoding/json"
+	consul "github.com/hashicorp/consul/api"
+	"github.com/onsi/gomega"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/contexes"
+	"github.com/orange-cloudfoundry/gsloc/signs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeConsul answers kv reads from pairs it holds and records transactions received without applying them.
+type fakeConsul struct {
+	mu    sync.Mutex
+	pairs map[string]*consul.KVPair
+	txns  []consul.TxnOps
+}
+
+func (f *fakeConsul) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case req.Method == http.MethodGet && strings.HasPrefix(req.URL.Path, "/v1/kv/"):
+		key := strings.TrimPrefix(req.URL.Path, "/v1/kv/")
+		if _, ok := req.URL.Query()["keys"]; ok {
+			keys := make([]string, 0)
+			for k := range f.pairs {
+				if strings.HasPrefix(k, key) {
+					keys = append(keys, k)
+				}
+			}
+			if len(keys) == 0 {
+				http.NotFound(w, req)
+				return
+			}
+			sort.Strings(keys)
+			_ = json.NewEncoder(w).Encode(keys)
+			return
+		}
+		pair, ok := f.pairs[key]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]*consul.KVPair{pair})
+	case req.Method == http.MethodPut && req.URL.Path == "/v1/txn":
+		txn := consul.TxnOps{}
+		err := json.NewDecoder(req.Body).Decode(&txn)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.txns = append(f.txns, txn)
+		_ = json.NewEncoder(w).Encode(&consul.TxnResponse{})
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func (f *fakeConsul) put(t *testing.T, key string, value []byte, modifyIndex uint64) {
+	t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.pairs[key] = &consul.KVPair{
+		Key:         key,
+		Value:       value,
+		ModifyIndex: modifyIndex,
+	}
+}
+
+func (f *fakeConsul) putRevision(t *testing.T, revision *opssvc.EntryRevision) {
+	t.Helper()
+	val, err := protojson.Marshal(revision)
+	if err != nil {
+		t.Fatalf("marshal revision: %v", err)
+	}
+	f.put(t, historyKey(revision.GetSignedEntry().GetEntry().GetFqdn(), revision.GetRevision()), val, revision.GetRevision())
+}
+
+func (f *fakeConsul) transactions() []consul.TxnOps {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.txns
+}
+
+func newTestHistoryServer(t *testing.T, maxRevisions int) (*Server, *fakeConsul) {
+	t.Helper()
+	fake := &fakeConsul{
+		pairs: make(map[string]*consul.KVPair),
+	}
+	httpServer := httptest.NewServer(fake)
+	t.Cleanup(httpServer.Close)
+	consulClient, err := consul.NewClient(&consul.Config{
+		Address: strings.TrimPrefix(httpServer.URL, "http://"),
+	})
+	if err != nil {
+		t.Fatalf("make consul client: %v", err)
+	}
+	return &Server{
+		consulClient: consulClient,
+		historyCnf: &config.HistoryConfig{
+			MaxRevisions: maxRevisions,
+		},
+		signer: signs.NewSigner(nil),
+	}, fake
+}
+
+func testHistoryEntry(ratio uint32) *entries.SignedEntry {
+	return &entries.SignedEntry{
+		Entry: &entries.Entry{
+			Fqdn: "app.example.com.",
+			MembersIpv4: []*entries.Member{
+				{Ip: "10.0.0.1", Dc: "dc1", Ratio: ratio},
+			},
+		},
+	}
+}
+
+func expectRevisionOp(g *gomega.WithT, op *consul.TxnOp, revision uint64) *opssvc.EntryRevision {
+	g.Expect(op.KV).ToNot(gomega.BeNil())
+	g.Expect(op.KV.Verb).To(gomega.Equal(consul.KVCAS))
+	g.Expect(op.KV.Key).To(gomega.Equal(historyKey("app.example.com.", revision)))
+	// index 0 makes revision only created when it does not exist
+	g.Expect(op.KV.Index).To(gomega.BeZero())
+	recorded := &opssvc.EntryRevision{}
+	g.Expect(protojson.Unmarshal(op.KV.Value, recorded)).To(gomega.Succeed())
+	g.Expect(recorded.GetRevision()).To(gomega.Equal(revision))
+	return recorded
+}
+
+func TestMakeHistoryTxOps(t *testing.T) {
+	cases := []struct {
+		name         string
+		maxRevisions int
+		// existing revisions, numbered from 1, with ratio of their member
+		existing     []uint32
+		lastIsDelete bool
+		action       opssvc.EntryRevision_Action
+		ratio        uint32
+		revision     uint64
+		noop         bool
+		pruned       []uint64
+	}{
+		{
+			name:         "first revision",
+			maxRevisions: 10,
+			action:       opssvc.EntryRevision_SET,
+			ratio:        1,
+			revision:     1,
+		},
+		{
+			name:         "changed entry",
+			maxRevisions: 10,
+			existing:     []uint32{1, 2, 3},
+			action:       opssvc.EntryRevision_SET,
+			ratio:        4,
+			revision:     4,
+		},
+		{
+			name:         "entry set without change",
+			maxRevisions: 10,
+			existing:     []uint32{1, 2, 3},
+			action:       opssvc.EntryRevision_SET,
+			ratio:        3,
+			noop:         true,
+		},
+		{
+			name:         "entry set again after deletion",
+			maxRevisions: 10,
+			existing:     []uint32{1, 2, 3},
+			lastIsDelete: true,
+			action:       opssvc.EntryRevision_SET,
+			ratio:        3,
+			revision:     4,
+		},
+		{
+			name:         "deletion is always recorded",
+			maxRevisions: 10,
+			existing:     []uint32{1, 2, 3},
+			action:       opssvc.EntryRevision_DELETE,
+			ratio:        3,
+			revision:     4,
+		},
+		{
+			name:         "oldest revisions pruned",
+			maxRevisions: 3,
+			existing:     []uint32{1, 2, 3, 4},
+			action:       opssvc.EntryRevision_SET,
+			ratio:        5,
+			revision:     5,
+			pruned:       []uint64{1, 2},
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			s, fake := newTestHistoryServer(t, c.maxRevisions)
+			for i, ratio := range c.existing {
+				action := opssvc.EntryRevision_SET
+				if c.lastIsDelete && i == len(c.existing)-1 {
+					action = opssvc.EntryRevision_DELETE
+				}
+				fake.putRevision(t, &opssvc.EntryRevision{
+					Revision:    uint64(i + 1),
+					Action:      action,
+					SignedEntry: testHistoryEntry(ratio),
+				})
+			}
+
+			ctx := contexes.SetCaller(context.Background(), "alice")
+			ops, err := s.makeHistoryTxOps(ctx, testHistoryEntry(c.ratio), c.action, 0)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			if c.noop {
+				g.Expect(ops).To(gomega.BeEmpty())
+				return
+			}
+			g.Expect(ops).To(gomega.HaveLen(1 + len(c.pruned)))
+			recorded := expectRevisionOp(g, ops[0], c.revision)
+			g.Expect(recorded.GetAction()).To(gomega.Equal(c.action))
+			g.Expect(recorded.GetAuthor()).To(gomega.Equal("alice"))
+			g.Expect(recorded.GetSignedEntry().GetEntry().GetMembersIpv4()[0].GetRatio()).To(gomega.Equal(c.ratio))
+			for i, revision := range c.pruned {
+				g.Expect(ops[i+1].KV.Verb).To(gomega.Equal(consul.KVDelete))
+				g.Expect(ops[i+1].KV.Key).To(gomega.Equal(historyKey("app.example.com.", revision)))
+			}
+		})
+	}
+}
+
+func TestMakeHistoryTxOpsCapsPruning(t *testing.T) {
+	g := gomega.NewWithT(t)
+	s, fake := newTestHistoryServer(t, 1)
+	const existing = 100
+	for i := 1; i <= existing; i++ {
+		fake.putRevision(t, &opssvc.EntryRevision{
+			Revision:    uint64(i),
+			Action:      opssvc.EntryRevision_SET,
+			SignedEntry: testHistoryEntry(uint32(i)),
+		})
+	}
+
+	ops, err := s.makeHistoryTxOps(context.Background(), testHistoryEntry(existing+1), opssvc.EntryRevision_SET, 0)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	// only oldest revisions are pruned, remaining ones are pruned by next revisions
+	g.Expect(ops).To(gomega.HaveLen(1 + maxPruneOps))
+	expectRevisionOp(g, ops[0], existing+1)
+	for i := 0; i < maxPruneOps; i++ {
+		g.Expect(ops[i+1].KV.Key).To(gomega.Equal(historyKey("app.example.com.", uint64(i+1))))
+	}
+}
+
+func TestRollbackEntry(t *testing.T) {
+	cases := []struct {
+		name string
+		// modify index of current entry, 0 when entry does not exist
+		modifyIndex uint64
+		checkVerb   consul.KVOp
+	}{
+		{
+			name:      "deleted entry",
+			checkVerb: consul.KVCheckNotExists,
+		},
+		{
+			name:        "existing entry",
+			modifyIndex: 42,
+			checkVerb:   consul.KVCheckIndex,
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			s, fake := newTestHistoryServer(t, 10)
+			fake.putRevision(t, &opssvc.EntryRevision{
+				Revision:    1,
+				Action:      opssvc.EntryRevision_SET,
+				SignedEntry: testHistoryEntry(1),
+			})
+			fake.putRevision(t, &opssvc.EntryRevision{
+				Revision:    2,
+				Action:      opssvc.EntryRevision_SET,
+				SignedEntry: testHistoryEntry(2),
+			})
+			if c.modifyIndex > 0 {
+				val, err := protojson.Marshal(testHistoryEntry(2))
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+				fake.put(t, config.ConsulKVEntriesPrefix+"app.example.com.", val, c.modifyIndex)
+			}
+
+			_, err := s.RollbackEntry(context.Background(), &opssvc.RollbackEntryRequest{
+				Fqdn:     "app.example.com",
+				Revision: 1,
+			})
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			txns := fake.transactions()
+			g.Expect(txns).To(gomega.HaveLen(1))
+			ops := txns[0]
+			g.Expect(ops).To(gomega.HaveLen(3))
+			// entry must not have changed since it has been read
+			g.Expect(ops[0].KV.Verb).To(gomega.Equal(c.checkVerb))
+			g.Expect(ops[0].KV.Key).To(gomega.Equal(config.ConsulKVEntriesPrefix + "app.example.com."))
+			g.Expect(ops[0].KV.Index).To(gomega.Equal(c.modifyIndex))
+
+			g.Expect(ops[1].KV.Verb).To(gomega.Equal(consul.KVSet))
+			g.Expect(ops[1].KV.Key).To(gomega.Equal(config.ConsulKVEntriesPrefix + "app.example.com."))
+			restored := &entries.SignedEntry{}
+			g.Expect(protojson.Unmarshal(ops[1].KV.Value, restored)).To(gomega.Succeed())
+			g.Expect(restored.GetEntry().GetMembersIpv4()[0].GetRatio()).To(gomega.Equal(uint32(1)))
+
+			recorded := expectRevisionOp(g, ops[2], 3)
+			g.Expect(recorded.GetAction()).To(gomega.Equal(opssvc.EntryRevision_ROLLBACK))
+			g.Expect(recorded.GetRollbackFrom()).To(gomega.Equal(uint64(1)))
+		})
+	}
+}
+
+func TestRollbackEntryUnknownRevision(t *testing.T) {
+	g := gomega.NewWithT(t)
+	s, fake := newTestHistoryServer(t, 10)
+
+	_, err := s.RollbackEntry(context.Background(), &opssvc.RollbackEntryRequest{
+		Fqdn:     "app.example.com",
+		Revision: 1,
+	})
+	g.Expect(status.Code(err)).To(gomega.Equal(codes.NotFound))
+	g.Expect(fake.transactions()).To(gomega.BeEmpty())
+}
+
+func TestDiffEntryRevisions(t *testing.T) {
+	g := gomega.NewWithT(t)
+	s, fake := newTestHistoryServer(t, 10)
+	fake.putRevision(t, &opssvc.EntryRevision{
+		Revision:    1,
+		Action:      opssvc.EntryRevision_SET,
+		SignedEntry: testHistoryEntry(1),
+	})
+	fake.putRevision(t, &opssvc.EntryRevision{
+		Revision:    2,
+		Action:      opssvc.EntryRevision_SET,
+		SignedEntry: testHistoryEntry(5),
+	})
+
+	resp, err := s.DiffEntryRevisions(context.Background(), &opssvc.DiffEntryRevisionsRequest{
+		Fqdn:         "app.example.com",
+		FromRevision: 1,
+		ToRevision:   2,
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(resp.GetDiff()).To(gomega.HavePrefix("--- revision 1\n+++ revision 2\n"))
+	g.Expect(resp.GetDiff()).To(gomega.MatchRegexp(`\n-\s+"ratio":\s+1,\n\+\s+"ratio":\s+5,\n`))
+	g.Expect(resp.GetFrom().GetRevision()).To(gomega.Equal(uint64(1)))
+	g.Expect(resp.GetTo().GetRevision()).To(gomega.Equal(uint64(2)))
+}
@@ -3,10 +3,10 @@ package gslb
 import (
 	"context"
 	consul "github.com/hashicorp/consul/api"
-	"github.com/hashicorp/go-multierror"
 	"github.com/miekg/dns"
 	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
 	gslbsvc "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/services/gslb/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
 	"github.com/samber/lo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,8 +14,6 @@ import (
 	"strings"
 )
 
-const maxTransactions = 64
-
 func (s *Server) SetMember(ctx context.Context, request *gslbsvc.SetMemberRequest) (*emptypb.Empty, error) {
 	err := request.ValidateAll()
 	if err != nil {
@@ -53,7 +51,7 @@ func (s *Server) SetMember(ctx context.Context, request *gslbsvc.SetMemberReques
 	} else {
 		signedEntry.GetEntry().MembersIpv4 = members
 	}
-	err = s.setSignedEntry(signedEntry)
+	err = s.setSignedEntry(ctx, signedEntry)
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +86,7 @@ func (s *Server) DeleteMember(ctx context.Context, request *gslbsvc.DeleteMember
 	} else {
 		signedEntry.GetEntry().MembersIpv4 = finalMembers
 	}
-	err = s.setSignedEntry(signedEntry)
+	err = s.setSignedEntry(ctx, signedEntry)
 	if err != nil {
 		return nil, err
 	}
@@ -124,31 +122,33 @@ func (s *Server) SetMembersStatus(ctx context.Context, request *gslbsvc.SetMembe
 	if err != nil {
 		return nil, err
 	}
-	var result error
-
-	txOpts := make(consul.TxnOps, 0)
+	builders := make([]txBuilder, 0)
 	mapToUpdate := make(map[string][]string)
 	for _, signedEnt := range signedEnts {
+		signedEnt := signedEnt
 		fqdn := signedEnt.GetEntry().GetFqdn()
 		updatedIpv4 := s.setStatusMember(fqdn, signedEnt.GetEntry().GetMembersIpv4(), request, mapToUpdate)
 		updatedIpv6 := s.setStatusMember(fqdn, signedEnt.GetEntry().GetMembersIpv6(), request, mapToUpdate)
 		if !updatedIpv4 && !updatedIpv6 {
 			continue
 		}
-		txOpt, err := s.makeTxEntry(signedEnt)
-		if err != nil {
-			result = multierror.Append(result, err)
-		}
-		txOpts = append(txOpts, txOpt)
-	}
-	if result != nil {
-		return nil, result
+		builders = append(builders, func() (consul.TxnOps, error) {
+			txOpt, err := s.makeTxEntry(signedEnt)
+			if err != nil {
+				return nil, err
+			}
+			historyOps, err := s.makeHistoryTxOps(ctx, signedEnt, opssvc.EntryRevision_SET, 0)
+			if err != nil {
+				return nil, err
+			}
+			return append(consul.TxnOps{txOpt}, historyOps...), nil
+		})
 	}
-	if len(txOpts) == 0 {
+	if len(builders) == 0 {
 		return &gslbsvc.SetMembersStatusResponse{}, nil
 	}
 	if !request.DryRun {
-		err = s.applyTx(txOpts)
+		err = s.applyTx(builders)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to update entries: %v", err)
 		}
@@ -167,26 +167,6 @@ func (s *Server) SetMembersStatus(ctx context.Context, request *gslbsvc.SetMembe
 	}, nil
 }
 
-func (s *Server) applyTx(opts consul.TxnOps) error {
-	var result error
-	if len(opts) == 0 {
-		return nil
-	}
-	todo := int(len(opts) / maxTransactions)
-	for i := 0; i <= todo; i++ {
-		max := (i + 1) * 64
-		if max > len(opts) {
-			max = len(opts)
-		}
-		subTxOpts := opts[i*64 : max]
-		_, _, _, err := s.consulClient.Txn().Txn(subTxOpts, nil)
-		if err != nil {
-			result = multierror.Append(result, err)
-		}
-	}
-	return result
-}
-
 func (s *Server) GetMember(ctx context.Context, request *gslbsvc.GetMemberRequest) (*gslbsvc.GetMemberResponse, error) {
 	err := request.ValidateAll()
 	if err != nil {
@@ -2,6 +2,7 @@ package gslb
 
 import (
 	"context"
+	"fmt"
 	consul "github.com/hashicorp/consul/api"
 	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
 	gslbsvc "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/services/gslb/v1"
@@ -62,11 +63,38 @@ func (s *Server) ListDcs(ctx context.Context, request *gslbsvc.ListDcsRequest) (
 }
 
 func (s *Server) ListPluginHealthChecks(context.Context, *emptypb.Empty) (*gslbsvc.ListPluginHealthChecksResponse, error) {
-	infos := make([]*gslbsvc.PluginHealthCheckInfo, 0)
+	infos := []*gslbsvc.PluginHealthCheckInfo{
+		{
+			Name: config.DnsHealthCheckPluginName,
+			Description: "Built-in dns health check, options are query_name (default to entry fqdn), query_type (default to A), " +
+				"protocol (udp or tcp, default to udp), expected_rcode (default to NOERROR), expected_answer_regex and expected_answer_contains",
+		},
+		{
+			Name: config.CompositeHealthCheckPluginName,
+			Description: "Built-in composite health check, options are checks (list of health checks made on their own port), " +
+				"mode (all, any or quorum, default to all) and quorum (number of checks which must pass in quorum mode)",
+		},
+		{
+			Name: config.PromQLHealthCheckPluginName,
+			Description: "Built-in promql health check, options are query (templated with {{.Fqdn}}, {{.Ip}} and {{.Dc}} of member), " +
+				"threshold, operator (check fails when value <operator> threshold, default to >), " +
+				"on_empty (pass or fail when query gives no value, default to fail) and endpoint (name of a configured prometheus endpoint, default to first one)",
+		},
+	}
 	for _, plugin := range s.hcPlugins {
+		description := plugin.Description
+		// persistent plugins describe themselves once started
+		if metadata := s.hcHandler.Plugins().Metadata(plugin.Name); metadata != nil {
+			if metadata.Description != "" {
+				description = metadata.Description
+			}
+			if metadata.Version != "" {
+				description = fmt.Sprintf("%s (version %s)", description, metadata.Version)
+			}
+		}
 		infos = append(infos, &gslbsvc.PluginHealthCheckInfo{
 			Name:        plugin.Name,
-			Description: plugin.Description,
+			Description: description,
 		})
 	}
 
@@ -0,0 +1,120 @@
+package gslb
+
+import (
+	"errors"
+	"fmt"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/diffs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+)
+
+func (s *Server) PlanEntries(stream opssvc.Ops_PlanEntriesServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	opts := first.GetOptions()
+	if opts == nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: first message must contain plan options")
+	}
+	err = first.ValidateAll()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	desired := make([]*entries.SignedEntry, 0)
+	for {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		err = request.ValidateAll()
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+		if request.GetSignedEntry() == nil {
+			return status.Errorf(codes.InvalidArgument, "invalid request: plan options must only be sent once")
+		}
+		desired = append(desired, request.GetSignedEntry())
+	}
+
+	err = s.validateSignedEntries(desired)
+	if err != nil {
+		return err
+	}
+	err = checkInScope(desired, opts.GetPrefix(), opts.GetTags())
+	if err != nil {
+		return err
+	}
+
+	mode := opssvc.ImportOptions_MERGE
+	if opts.GetDeleteUnmanaged() {
+		mode = opssvc.ImportOptions_REPLACE
+	}
+	plan, err := s.makeEntriesPlan(desired, mode, opts.GetPrefix(), opts.GetTags())
+	if err != nil {
+		return err
+	}
+	changes, err := makeEntryChanges(plan)
+	if err != nil {
+		return err
+	}
+
+	applied := false
+	failed := make([]string, 0)
+	if opts.GetApply() && len(changes) > 0 {
+		failed, err = s.commitEntriesPlan(stream.Context(), plan)
+		if err != nil {
+			return err
+		}
+		applied = true
+	}
+	return stream.SendAndClose(&opssvc.PlanEntriesResponse{
+		Changes:   changes,
+		Unmanaged: plan.unmanaged,
+		Applied:   applied,
+		Failed:    failed,
+	})
+}
+
+func makeEntryChanges(plan *entriesPlan) ([]*opssvc.EntryChange, error) {
+	changes := make([]*opssvc.EntryChange, 0, len(plan.creates)+len(plan.updates)+len(plan.deletes))
+	appendChange := func(fqdn string, action opssvc.EntryChange_Action, from, to *entries.SignedEntry) error {
+		diff, err := diffs.SignedEntries(from, to)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to diff entry %s: %v", fqdn, err)
+		}
+		changes = append(changes, &opssvc.EntryChange{
+			Fqdn:   fqdn,
+			Action: action,
+			Diff:   fmt.Sprintf("--- %s (current)\n+++ %s (desired)\n%s", fqdn, fqdn, diff),
+		})
+		return nil
+	}
+	for _, signedEnt := range plan.creates {
+		err := appendChange(signedEnt.GetEntry().GetFqdn(), opssvc.EntryChange_CREATE, nil, signedEnt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, signedEnt := range plan.updates {
+		fqdn := signedEnt.GetEntry().GetFqdn()
+		err := appendChange(fqdn, opssvc.EntryChange_UPDATE, plan.current[fqdn], signedEnt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, signedEnt := range plan.deletes {
+		err := appendChange(signedEnt.GetEntry().GetFqdn(), opssvc.EntryChange_DELETE, signedEnt, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
+}
@@ -0,0 +1,106 @@
+package gslb
+
+import (
+	"context"
+	consul "github.com/hashicorp/consul/api"
+	"github.com/miekg/dns"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/healthchecks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Server) SetEntryPolicy(ctx context.Context, request *opssvc.SetEntryPolicyRequest) (*emptypb.Empty, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+	policy := request.GetPolicy()
+	policy.Fqdn = dns.CanonicalName(policy.GetFqdn())
+
+	if damping := policy.GetHealth().GetFlapDamping(); damping.GetMaxFlaps() > 0 && damping.GetWindow().AsDuration() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: flap damping window must be set when max flaps is set")
+	}
+
+	if weights := policy.GetAdaptiveWeights(); weights.GetMaxWeight() > 0 && weights.GetMinWeight() > weights.GetMaxWeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: adaptive weights min weight must not exceed max weight")
+	}
+
+	priorities := make(map[uint32]struct{})
+	for _, group := range policy.GetPriorityGroups().GetGroups() {
+		if _, ok := priorities[group.GetPriority()]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: priority %d is set on several priority groups", group.GetPriority())
+		}
+		priorities[group.GetPriority()] = struct{}{}
+	}
+
+	if tlsPolicy := policy.GetHealth().GetTls(); tlsPolicy != nil {
+		err := healthchecks.ValidateHealthTlsPolicy(tlsPolicy)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+	}
+
+	pair, _, err := s.consulClient.KV().Get(config.ConsulKVEntriesPrefix+policy.GetFqdn(), nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get entry: %v", err)
+	}
+	if pair == nil {
+		return nil, status.Errorf(codes.NotFound, "entry %s not found", policy.GetFqdn())
+	}
+
+	val, err := protojson.Marshal(policy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal policy: %v", err)
+	}
+	_, err = s.consulClient.KV().Put(&consul.KVPair{
+		Key:   config.ConsulKVPoliciesPrefix + policy.GetFqdn(),
+		Value: val,
+	}, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write policy: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) GetEntryPolicy(ctx context.Context, request *opssvc.GetEntryPolicyRequest) (*opssvc.EntryPolicy, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	fqdn := dns.CanonicalName(request.GetFqdn())
+	pair, _, err := s.consulClient.KV().Get(config.ConsulKVPoliciesPrefix+fqdn, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get policy: %v", err)
+	}
+	if pair == nil {
+		return &opssvc.EntryPolicy{
+			Fqdn:   fqdn,
+			Health: &opssvc.HealthPolicy{},
+		}, nil
+	}
+	policy := &opssvc.EntryPolicy{}
+	err = protojson.Unmarshal(pair.Value, policy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal policy: %v", err)
+	}
+	return policy, nil
+}
+
+func (s *Server) DeleteEntryPolicy(ctx context.Context, request *opssvc.DeleteEntryPolicyRequest) (*emptypb.Empty, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	fqdn := dns.CanonicalName(request.GetFqdn())
+	_, err = s.consulClient.KV().Delete(config.ConsulKVPoliciesPrefix+fqdn, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete policy: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
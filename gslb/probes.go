@@ -0,0 +1,178 @@
+package gslb
+
+import (
+	"context"
+	"errors"
+	"github.com/miekg/dns"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/healthchecks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"time"
+)
+
+func (s *Server) GetMemberHealthHistory(ctx context.Context, request *opssvc.GetMemberHealthHistoryRequest) (*opssvc.GetMemberHealthHistoryResponse, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	fqdn := dns.CanonicalName(request.GetFqdn())
+	histories := s.hcHandler.History(fqdn, request.GetIp())
+	// members of other dcs are also probed when entry uses cross dc quorum
+	memberDcs := make(map[string]string)
+	if signedEntry, err := s.gslocConsul.RetrieveSignedEntry(fqdn); err == nil {
+		members := append([]*entries.Member{}, signedEntry.GetEntry().GetMembersIpv4()...)
+		members = append(members, signedEntry.GetEntry().GetMembersIpv6()...)
+		for _, member := range members {
+			memberDcs[member.GetIp()] = member.GetDc()
+		}
+	}
+	members := make([]*opssvc.MemberHealthHistory, 0, len(histories))
+	for ip, history := range histories {
+		records := make([]*opssvc.ProbeRecord, len(history.Probes))
+		for i, probe := range history.Probes {
+			record := &opssvc.ProbeRecord{
+				Timestamp: timestamppb.New(probe.Timestamp),
+				Duration:  durationpb.New(probe.Duration),
+				Success:   probe.Err == nil,
+			}
+			if probe.Err != nil {
+				record.Error = probe.Err.Error()
+			}
+			records[i] = record
+		}
+		dc, ok := memberDcs[ip]
+		if !ok {
+			dc = s.hcHandler.DcName()
+		}
+		members = append(members, &opssvc.MemberHealthHistory{
+			Ip:     ip,
+			Dc:     dc,
+			Probes: records,
+			State:  convertHealthState(history.State),
+		})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].GetIp() < members[j].GetIp()
+	})
+	return &opssvc.GetMemberHealthHistoryResponse{
+		Members: members,
+	}, nil
+}
+
+func convertHealthState(result *healthchecks.ProbeResult) *opssvc.MemberHealthState {
+	state := &opssvc.MemberHealthState{
+		Healthy:              result.Healthy,
+		ConsecutiveSuccesses: result.Successes,
+		ConsecutiveFailures:  result.Failures,
+		HealthyThreshold:     result.HealthyThreshold,
+		UnhealthyThreshold:   result.UnhealthyThreshold,
+	}
+	if !result.DampedUntil.IsZero() {
+		state.DampedUntil = timestamppb.New(result.DampedUntil)
+	}
+	if result.CrossDc != nil {
+		state.CrossDc = &opssvc.CrossDcHealth{
+			Healthy:   result.CrossDc.Healthy,
+			Unhealthy: uint32(result.CrossDc.Unhealthy),
+			Quorum:    uint32(result.CrossDc.Quorum),
+			Dcs:       make([]*opssvc.DcHealth, len(result.CrossDc.Dcs)),
+		}
+		for i, dcHealth := range result.CrossDc.Dcs {
+			state.CrossDc.Dcs[i] = &opssvc.DcHealth{
+				Dc:      dcHealth.Dc,
+				Healthy: dcHealth.Healthy,
+				Output:  dcHealth.Output,
+			}
+		}
+	}
+	return state
+}
+
+func (s *Server) TestHealthCheck(ctx context.Context, request *opssvc.TestHealthCheckRequest) (*opssvc.TestHealthCheckResponse, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+	err = s.validatePluginHealthCheck(request.GetHealthcheck())
+	if err != nil {
+		return nil, err
+	}
+
+	fqdn := dns.CanonicalName(request.GetFqdn())
+	results, err := s.hcHandler.Test(fqdn, request.GetHealthcheck(), request.GetIps())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid health check: %v", err)
+	}
+	memberResults := make([]*opssvc.MemberCheckResult, len(results))
+	for i, result := range results {
+		memberResult := &opssvc.MemberCheckResult{
+			Ip:       result.Ip,
+			Success:  result.Err == nil,
+			Duration: durationpb.New(result.Duration),
+			Details:  result.Details,
+		}
+		if result.Err != nil {
+			memberResult.Error = result.Err.Error()
+		}
+		memberResults[i] = memberResult
+	}
+	return &opssvc.TestHealthCheckResponse{
+		Results: memberResults,
+	}, nil
+}
+
+func (s *Server) RecheckMember(ctx context.Context, request *opssvc.RecheckMemberRequest) (*opssvc.RecheckMemberResponse, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	fqdn := dns.CanonicalName(request.GetFqdn())
+	signedEntry, err := s.gslocConsul.RetrieveSignedEntry(fqdn)
+	if err != nil {
+		return nil, err
+	}
+	members := append([]*entries.Member{}, signedEntry.GetEntry().GetMembersIpv4()...)
+	members = append(members, signedEntry.GetEntry().GetMembersIpv6()...)
+	var member *entries.Member
+	for _, m := range members {
+		if m.GetIp() == request.GetIp() {
+			member = m
+			break
+		}
+	}
+	if member == nil {
+		return nil, status.Errorf(codes.NotFound, "member %s not found in entry %s", request.GetIp(), fqdn)
+	}
+	if member.GetDc() != s.hcHandler.DcName() {
+		return nil, status.Errorf(codes.FailedPrecondition, "member %s is in dc %s, recheck must be asked to a node of this dc", request.GetIp(), member.GetDc())
+	}
+	if s.hcHandler.IsDisabled(fqdn, member.GetIp()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "member %s is disabled", request.GetIp())
+	}
+
+	start := time.Now()
+	result, err := s.hcHandler.Check(fqdn, member.GetIp(), signedEntry.GetHealthcheck())
+	if errors.Is(err, healthchecks.ErrProbeRejected) {
+		return nil, status.Errorf(codes.ResourceExhausted, "failed to check member: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check member: %v", err)
+	}
+	duration := time.Since(start)
+	err = s.consulDisco.PushMemberHealth(signedEntry, member, result)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to push member health to consul: %v", err)
+	}
+	return &opssvc.RecheckMemberResponse{
+		Healthy:  result.Healthy,
+		Output:   result.String(),
+		Duration: durationpb.New(duration),
+	}, nil
+}
@@ -3,22 +3,39 @@ package gslb
 import (
 	consul "github.com/hashicorp/consul/api"
 	gslbsvc "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/services/gslb/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/audit"
 	"github.com/orange-cloudfoundry/gsloc/config"
 	"github.com/orange-cloudfoundry/gsloc/disco"
+	"github.com/orange-cloudfoundry/gsloc/healthchecks"
+	"github.com/orange-cloudfoundry/gsloc/signs"
 )
 
 type Server struct {
 	consulClient *consul.Client
 	gslocConsul  *disco.GslocConsul
 	hcPlugins    []*config.PluginHealthCheckConfig
+	historyCnf   *config.HistoryConfig
+	signalsCnf   *config.SignalsConfig
+	auditor      *audit.Auditor
+	signer       *signs.Signer
+	hcHandler    *healthchecks.HcHandler
+	consulDisco  *disco.ConsulDiscoverer
 	gslbsvc.UnimplementedGSLBServer
+	opssvc.UnimplementedOpsServer
 }
 
-func NewServer(consulClient *consul.Client, gslocConsul *disco.GslocConsul, plugins []*config.PluginHealthCheckConfig) (*Server, error) {
+func NewServer(consulClient *consul.Client, gslocConsul *disco.GslocConsul, plugins []*config.PluginHealthCheckConfig, historyCnf *config.HistoryConfig, signalsCnf *config.SignalsConfig, auditor *audit.Auditor, signer *signs.Signer, hcHandler *healthchecks.HcHandler, consulDisco *disco.ConsulDiscoverer) (*Server, error) {
 	s := &Server{
 		consulClient: consulClient,
 		gslocConsul:  gslocConsul,
 		hcPlugins:    plugins,
+		historyCnf:   historyCnf,
+		signalsCnf:   signalsCnf,
+		auditor:      auditor,
+		signer:       signer,
+		hcHandler:    hcHandler,
+		consulDisco:  consulDisco,
 	}
 	return s, nil
 }
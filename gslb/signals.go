@@ -0,0 +1,168 @@
+package gslb
+
+import (
+	"context"
+	consul "github.com/hashicorp/consul/api"
+	"github.com/miekg/dns"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/contexes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"time"
+)
+
+func signalKey(fqdn, ip string) string {
+	return config.ConsulKVSignalsPrefix + fqdn + "/" + ip
+}
+
+func (s *Server) ReportMemberSignal(ctx context.Context, request *opssvc.ReportMemberSignalRequest) (*opssvc.MemberSignal, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+	maxDuration := time.Duration(*s.signalsCnf.MaxDuration)
+	if request.GetDuration().AsDuration() > maxDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: duration must not exceed %s", maxDuration)
+	}
+
+	fqdn := dns.CanonicalName(request.GetFqdn())
+	signedEntry, err := s.gslocConsul.RetrieveSignedEntry(fqdn)
+	if err != nil {
+		return nil, err
+	}
+	members := append([]*entries.Member{}, signedEntry.GetEntry().GetMembersIpv4()...)
+	members = append(members, signedEntry.GetEntry().GetMembersIpv6()...)
+	found := false
+	for _, member := range members {
+		if member.GetIp() == request.GetIp() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "member %s not found in entry %s", request.GetIp(), fqdn)
+	}
+
+	now := time.Now()
+	signal := &opssvc.MemberSignal{
+		Fqdn:       fqdn,
+		Ip:         request.GetIp(),
+		Level:      request.GetLevel(),
+		Reason:     request.GetReason(),
+		Reporter:   contexes.GetCaller(ctx),
+		ReportedAt: timestamppb.New(now),
+		ExpiresAt:  timestamppb.New(now.Add(request.GetDuration().AsDuration())),
+	}
+	err = s.signer.SignSignal(signal)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign signal: %v", err)
+	}
+	val, err := protojson.Marshal(signal)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal signal: %v", err)
+	}
+	// expired signals of entry are removed at the same time, they are already ignored by retrievers
+	_, expired, err := s.listSignals(fqdn)
+	if err != nil {
+		return nil, err
+	}
+	builders := []txBuilder{
+		staticTxBuilder(&consul.TxnOp{
+			KV: &consul.KVTxnOp{
+				Verb:  consul.KVSet,
+				Key:   signalKey(fqdn, signal.GetIp()),
+				Value: val,
+			},
+		}),
+	}
+	for _, key := range expired {
+		if key == signalKey(fqdn, signal.GetIp()) {
+			continue
+		}
+		builders = append(builders, staticTxBuilder(&consul.TxnOp{
+			KV: &consul.KVTxnOp{
+				Verb: consul.KVDelete,
+				Key:  key,
+			},
+		}))
+	}
+	err = s.applyTx(builders)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write signal: %v", err)
+	}
+	return signal, nil
+}
+
+func (s *Server) ClearMemberSignal(ctx context.Context, request *opssvc.ClearMemberSignalRequest) (*emptypb.Empty, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	fqdn := dns.CanonicalName(request.GetFqdn())
+	_, err = s.consulClient.KV().Delete(signalKey(fqdn, request.GetIp()), nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete signal: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListMemberSignals(ctx context.Context, request *opssvc.ListMemberSignalsRequest) (*opssvc.ListMemberSignalsResponse, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	fqdn := ""
+	if request.GetFqdn() != "" {
+		fqdn = dns.CanonicalName(request.GetFqdn())
+	}
+	signals, _, err := s.listSignals(fqdn)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(signals, func(i, j int) bool {
+		if signals[i].GetFqdn() != signals[j].GetFqdn() {
+			return signals[i].GetFqdn() < signals[j].GetFqdn()
+		}
+		return signals[i].GetIp() < signals[j].GetIp()
+	})
+	return &opssvc.ListMemberSignalsResponse{
+		Signals: signals,
+	}, nil
+}
+
+// listSignals gives signals not expired of entry fqdn, or of all entries if fqdn is empty,
+// and keys of expired ones.
+func (s *Server) listSignals(fqdn string) ([]*opssvc.MemberSignal, []string, error) {
+	prefix := config.ConsulKVSignalsPrefix
+	if fqdn != "" {
+		prefix += fqdn + "/"
+	}
+	pairs, _, err := s.consulClient.KV().List(prefix, nil)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to list signals: %v", err)
+	}
+	now := time.Now()
+	signals := make([]*opssvc.MemberSignal, 0, len(pairs))
+	expired := make([]string, 0)
+	for _, pair := range pairs {
+		signal := &opssvc.MemberSignal{}
+		err := protojson.Unmarshal(pair.Value, signal)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to unmarshal signal %s: %v", pair.Key, err)
+		}
+		if !signal.GetExpiresAt().AsTime().After(now) {
+			expired = append(expired, pair.Key)
+			continue
+		}
+		signals = append(signals, signal)
+	}
+	return signals, expired, nil
+}
@@ -0,0 +1,43 @@
+package gslb
+
+import (
+	"context"
+	"github.com/hashicorp/go-multierror"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ResignEntries(ctx context.Context, request *opssvc.ResignEntriesRequest) (*opssvc.ResignEntriesResponse, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	signedEnts, err := s.gslocConsul.ListEntries(request.GetPrefix(), request.GetTags())
+	if err != nil {
+		return nil, err
+	}
+	var result error
+	builders := make([]txBuilder, 0)
+	fqdns := make([]string, 0)
+	for _, signedEnt := range signedEnts {
+		txOpt, err := s.makeTxEntry(signedEnt)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+		builders = append(builders, staticTxBuilder(txOpt))
+		fqdns = append(fqdns, signedEnt.GetEntry().GetFqdn())
+	}
+	if result != nil {
+		return nil, result
+	}
+	err = s.applyTx(builders)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update entries: %v", err)
+	}
+	return &opssvc.ResignEntriesResponse{
+		Fqdns: fqdns,
+	}, nil
+}
@@ -0,0 +1,327 @@
+package gslb
+
+import (
+	"context"
+	"errors"
+	consul "github.com/hashicorp/consul/api"
+	"github.com/miekg/dns"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/diffs"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"sort"
+	"strings"
+)
+
+// entriesPlan describes changes to apply on entries to reach wanted state.
+type entriesPlan struct {
+	creates []*entries.SignedEntry
+	updates []*entries.SignedEntry
+	deletes []*entries.SignedEntry
+	skipped []string
+	// entries in scope which are not wanted and not deleted
+	unmanaged []string
+	// all current entries by fqdn
+	current map[string]*entries.SignedEntry
+	// consul modify index of all current entries by fqdn, used to detect concurrent changes
+	indexes map[string]uint64
+}
+
+func (s *Server) ExportEntries(request *opssvc.ExportEntriesRequest, stream opssvc.Ops_ExportEntriesServer) error {
+	err := request.ValidateAll()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	signedEnts, err := s.gslocConsul.ListEntries(request.GetPrefix(), request.GetTags())
+	if err != nil {
+		return err
+	}
+	sort.Slice(signedEnts, func(i, j int) bool {
+		return signedEnts[i].GetEntry().GetFqdn() < signedEnts[j].GetEntry().GetFqdn()
+	})
+	for _, signedEnt := range signedEnts {
+		signedEnt.Signature = ""
+		err := stream.Send(signedEnt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) ImportEntries(stream opssvc.Ops_ImportEntriesServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	opts := first.GetOptions()
+	if opts == nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: first message must contain import options")
+	}
+	err = first.ValidateAll()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	wanted := make([]*entries.SignedEntry, 0)
+	for {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		err = request.ValidateAll()
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+		if request.GetSignedEntry() == nil {
+			return status.Errorf(codes.InvalidArgument, "invalid request: import options must only be sent once")
+		}
+		wanted = append(wanted, request.GetSignedEntry())
+	}
+
+	err = s.validateSignedEntries(wanted)
+	if err != nil {
+		return err
+	}
+
+	err = checkInScope(wanted, opts.GetPrefix(), opts.GetTags())
+	if err != nil {
+		return err
+	}
+
+	plan, err := s.makeEntriesPlan(wanted, opts.GetMode(), opts.GetPrefix(), opts.GetTags())
+	if err != nil {
+		return err
+	}
+	failed := make([]string, 0)
+	if !opts.GetDryRun() {
+		failed, err = s.commitEntriesPlan(stream.Context(), plan)
+		if err != nil {
+			return err
+		}
+	}
+	return stream.SendAndClose(&opssvc.ImportEntriesResponse{
+		Created: lo.Without(fqdnsOf(plan.creates), failed...),
+		Updated: lo.Without(fqdnsOf(plan.updates), failed...),
+		Deleted: lo.Without(fqdnsOf(plan.deletes), failed...),
+		Skipped: plan.skipped,
+		Failed:  failed,
+	})
+}
+
+// validateSignedEntries check entries as SetEntry would do and canonicalize their fqdn.
+func (s *Server) validateSignedEntries(signedEnts []*entries.SignedEntry) error {
+	dcs, err := s.listDcs()
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]struct{})
+	for _, signedEnt := range signedEnts {
+		if signedEnt.GetEntry() == nil || signedEnt.GetHealthcheck() == nil {
+			return status.Errorf(codes.InvalidArgument, "invalid entry: entry and healthcheck are required")
+		}
+		err := signedEnt.GetEntry().ValidateAll()
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid entry %s: %v", signedEnt.GetEntry().GetFqdn(), err)
+		}
+		err = signedEnt.GetHealthcheck().ValidateAll()
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid entry %s: %v", signedEnt.GetEntry().GetFqdn(), err)
+		}
+		err = s.checkMembersInDCS(signedEnt.GetEntry(), dcs)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid entry %s: %v", signedEnt.GetEntry().GetFqdn(), err)
+		}
+		err = s.validatePluginHealthCheck(signedEnt.GetHealthcheck())
+		if err != nil {
+			return err
+		}
+		signedEnt.Entry.Fqdn = dns.CanonicalName(signedEnt.GetEntry().GetFqdn())
+		if _, ok := seen[signedEnt.GetEntry().GetFqdn()]; ok {
+			return status.Errorf(codes.InvalidArgument, "entry %s is defined twice", signedEnt.GetEntry().GetFqdn())
+		}
+		seen[signedEnt.GetEntry().GetFqdn()] = struct{}{}
+	}
+	return nil
+}
+
+// makeEntriesPlan compare wanted entries with current ones. Entries matching prefix and tags which
+// are not wanted are deleted in replace mode and reported as unmanaged otherwise.
+func (s *Server) makeEntriesPlan(wanted []*entries.SignedEntry, mode opssvc.ImportOptions_Mode, prefix string, tags []string) (*entriesPlan, error) {
+	indexedEnts, err := s.gslocConsul.ListIndexedEntries("", nil)
+	if err != nil {
+		return nil, err
+	}
+	plan := &entriesPlan{
+		creates:   make([]*entries.SignedEntry, 0),
+		updates:   make([]*entries.SignedEntry, 0),
+		deletes:   make([]*entries.SignedEntry, 0),
+		skipped:   make([]string, 0),
+		unmanaged: make([]string, 0),
+		current:   make(map[string]*entries.SignedEntry, len(indexedEnts)),
+		indexes:   make(map[string]uint64, len(indexedEnts)),
+	}
+	for _, indexedEnt := range indexedEnts {
+		fqdn := indexedEnt.SignedEntry.GetEntry().GetFqdn()
+		plan.current[fqdn] = indexedEnt.SignedEntry
+		plan.indexes[fqdn] = indexedEnt.ModifyIndex
+	}
+
+	wantedFqdns := make(map[string]struct{}, len(wanted))
+	for _, signedEnt := range wanted {
+		fqdn := signedEnt.GetEntry().GetFqdn()
+		wantedFqdns[fqdn] = struct{}{}
+		currentEnt, exists := plan.current[fqdn]
+		if !exists {
+			plan.creates = append(plan.creates, signedEnt)
+			continue
+		}
+		if mode == opssvc.ImportOptions_SKIP_EXISTING || diffs.SameContent(currentEnt, signedEnt) {
+			plan.skipped = append(plan.skipped, fqdn)
+			continue
+		}
+		plan.updates = append(plan.updates, signedEnt)
+	}
+
+	for _, indexedEnt := range indexedEnts {
+		signedEnt := indexedEnt.SignedEntry
+		fqdn := signedEnt.GetEntry().GetFqdn()
+		if _, ok := wantedFqdns[fqdn]; ok || !inScope(signedEnt, prefix, tags) {
+			continue
+		}
+		if mode == opssvc.ImportOptions_REPLACE {
+			plan.deletes = append(plan.deletes, signedEnt)
+			continue
+		}
+		plan.unmanaged = append(plan.unmanaged, fqdn)
+	}
+	sort.Strings(plan.unmanaged)
+	return plan, nil
+}
+
+// commitEntriesPlan applies plan in as few transactions as possible and gives fqdns of entries not applied,
+// changes of an entry are not applied when it has been changed since plan was made.
+// An error is given when nothing has been applied.
+func (s *Server) commitEntriesPlan(ctx context.Context, plan *entriesPlan) ([]string, error) {
+	toApply := make([]*entries.SignedEntry, 0, len(plan.creates)+len(plan.updates)+len(plan.deletes))
+	toApply = append(toApply, plan.creates...)
+	toApply = append(toApply, plan.updates...)
+	toApply = append(toApply, plan.deletes...)
+	if len(toApply) == 0 {
+		return []string{}, nil
+	}
+	failedIndexes, err := s.applyTxChunks(s.makeEntriesPlanTxBuilders(ctx, plan))
+	if len(failedIndexes) == len(toApply) {
+		return nil, status.Errorf(codes.Aborted, "failed to apply changes, entries may have changed meanwhile: %v", err)
+	}
+	failed := make([]string, len(failedIndexes))
+	for i, index := range failedIndexes {
+		failed[i] = toApply[index].GetEntry().GetFqdn()
+	}
+	sort.Strings(failed)
+	return failed, nil
+}
+
+// makeEntriesPlanTxBuilders gives transaction operations to apply plan with revisions in history,
+// operations of an entry are given by a same builder, they fail if entry has been changed since plan was made.
+func (s *Server) makeEntriesPlanTxBuilders(ctx context.Context, plan *entriesPlan) []txBuilder {
+	builders := make([]txBuilder, 0, len(plan.creates)+len(plan.updates)+len(plan.deletes))
+	toSets := make([]*entries.SignedEntry, 0, len(plan.creates)+len(plan.updates))
+	toSets = append(toSets, plan.creates...)
+	toSets = append(toSets, plan.updates...)
+	for _, signedEnt := range toSets {
+		toSet := proto.Clone(signedEnt).(*entries.SignedEntry)
+		builders = append(builders, func() (consul.TxnOps, error) {
+			txOpt, err := s.makeTxEntry(toSet)
+			if err != nil {
+				return nil, err
+			}
+			txOpt.KV.Verb = consul.KVCAS
+			txOpt.KV.Index = plan.indexes[toSet.GetEntry().GetFqdn()]
+			historyOps, err := s.makeHistoryTxOps(ctx, toSet, opssvc.EntryRevision_SET, 0)
+			if err != nil {
+				return nil, err
+			}
+			return append(consul.TxnOps{txOpt}, historyOps...), nil
+		})
+	}
+	for _, signedEnt := range plan.deletes {
+		signedEnt := signedEnt
+		builders = append(builders, func() (consul.TxnOps, error) {
+			fqdn := signedEnt.GetEntry().GetFqdn()
+			historyOps, err := s.makeHistoryTxOps(ctx, signedEnt, opssvc.EntryRevision_DELETE, 0)
+			if err != nil {
+				return nil, err
+			}
+			txOpts := consul.TxnOps{
+				{
+					KV: &consul.KVTxnOp{
+						Verb:  consul.KVDeleteCAS,
+						Key:   config.ConsulKVEntriesPrefix + fqdn,
+						Index: plan.indexes[fqdn],
+					},
+				},
+				{
+					KV: &consul.KVTxnOp{
+						Verb: consul.KVDelete,
+						Key:  config.ConsulKVPoliciesPrefix + fqdn,
+					},
+				},
+				{
+					KV: &consul.KVTxnOp{
+						Verb: consul.KVDeleteTree,
+						Key:  config.ConsulKVDcHealthPrefix + fqdn + "/",
+					},
+				},
+				{
+					KV: &consul.KVTxnOp{
+						Verb: consul.KVDeleteTree,
+						Key:  config.ConsulKVSignalsPrefix + fqdn + "/",
+					},
+				},
+				{
+					KV: &consul.KVTxnOp{
+						Verb: consul.KVDeleteTree,
+						Key:  config.ConsulKVWeightsPrefix + fqdn + "/",
+					},
+				},
+			}
+			return append(txOpts, historyOps...), nil
+		})
+	}
+	return builders
+}
+
+func inScope(signedEnt *entries.SignedEntry, prefix string, tags []string) bool {
+	if !strings.HasPrefix(signedEnt.GetEntry().GetFqdn(), prefix) {
+		return false
+	}
+	return lo.Every[string](signedEnt.GetEntry().GetTags(), tags)
+}
+
+// checkInScope rejects entries not matching prefix and tags, they would not be managed by a next import or plan.
+func checkInScope(signedEnts []*entries.SignedEntry, prefix string, tags []string) error {
+	for _, signedEnt := range signedEnts {
+		if !inScope(signedEnt, prefix, tags) {
+			return status.Errorf(codes.InvalidArgument, "entry %s is out of scope, it must match prefix and tags", signedEnt.GetEntry().GetFqdn())
+		}
+	}
+	return nil
+}
+
+func fqdnsOf(signedEnts []*entries.SignedEntry) []string {
+	fqdns := make([]string, len(signedEnts))
+	for i, signedEnt := range signedEnts {
+		fqdns[i] = signedEnt.GetEntry().GetFqdn()
+	}
+	return fqdns
+}
@@ -0,0 +1,146 @@
+package gslb
+
+import (
+	"errors"
+	"fmt"
+	consul "github.com/hashicorp/consul/api"
+	"github.com/hashicorp/go-multierror"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/samber/lo"
+	"strings"
+)
+
+const maxTransactions = 64
+
+// maxHistoryRetries is number of times operations are made again when a revision has been recorded concurrently
+const maxHistoryRetries = 5
+
+// errHistoryConflict is given when a revision to record has been recorded meanwhile by another writer
+var errHistoryConflict = errors.New("revision has been recorded concurrently")
+
+// txBuilder gives operations which must be applied in a same transaction, e.g. an entry and its revision,
+// it is called again to get up-to-date operations when a revision has been recorded concurrently.
+type txBuilder func() (consul.TxnOps, error)
+
+func staticTxBuilder(opts ...*consul.TxnOp) txBuilder {
+	return func() (consul.TxnOps, error) {
+		return opts, nil
+	}
+}
+
+// commitTx apply operations in a single transaction, all operations must succeed or none is applied.
+func (s *Server) commitTx(opts consul.TxnOps) error {
+	ok, resp, _, err := s.consulClient.Txn().Txn(opts, nil)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+	errMsgs := make([]string, 0)
+	conflict := false
+	if resp != nil {
+		for _, txErr := range resp.Errors {
+			errMsgs = append(errMsgs, txErr.What)
+			if txErr.OpIndex < len(opts) && isHistoryWrite(opts[txErr.OpIndex]) {
+				conflict = true
+			}
+		}
+	}
+	if conflict {
+		return fmt.Errorf("transaction rolled back: %w: %s", errHistoryConflict, strings.Join(errMsgs, ", "))
+	}
+	return fmt.Errorf("transaction rolled back: %s", strings.Join(errMsgs, ", "))
+}
+
+// commitTxBuilders apply operations of all builders in a single transaction,
+// operations are made again and retried when a revision has been recorded concurrently.
+func (s *Server) commitTxBuilders(builders ...txBuilder) error {
+	opts, err := buildTxOps(builders)
+	if err != nil {
+		return err
+	}
+	return s.commitTxRetry(opts, builders)
+}
+
+func (s *Server) commitTxRetry(opts consul.TxnOps, builders []txBuilder) error {
+	for i := 1; ; i++ {
+		if len(opts) == 0 {
+			return nil
+		}
+		err := s.commitTx(opts)
+		if !errors.Is(err, errHistoryConflict) || i >= maxHistoryRetries {
+			return err
+		}
+		opts, err = buildTxOps(builders)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// applyTx apply operations of builders in as few transactions as possible,
+// operations of a same builder are never split between transactions.
+// All operations are made before anything is applied, an error at this step means nothing has been applied.
+func (s *Server) applyTx(builders []txBuilder) error {
+	_, err := s.applyTxChunks(builders)
+	return err
+}
+
+// applyTxChunks is applyTx which also gives index of builders whose operations have not been applied,
+// operations of other builders are applied even if some transactions failed.
+func (s *Server) applyTxChunks(builders []txBuilder) ([]int, error) {
+	type txChunk struct {
+		opts     consul.TxnOps
+		builders []txBuilder
+		indexes  []int
+	}
+	chunks := make([]*txChunk, 0)
+	chunk := &txChunk{}
+	for i, builder := range builders {
+		opts, err := builder()
+		if err != nil {
+			return lo.Range(len(builders)), err
+		}
+		if len(opts) == 0 {
+			continue
+		}
+		if len(chunk.opts) > 0 && len(chunk.opts)+len(opts) > maxTransactions {
+			chunks = append(chunks, chunk)
+			chunk = &txChunk{}
+		}
+		chunk.opts = append(chunk.opts, opts...)
+		chunk.builders = append(chunk.builders, builder)
+		chunk.indexes = append(chunk.indexes, i)
+	}
+	if len(chunk.opts) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	failed := make([]int, 0)
+	var result error
+	for _, chunk := range chunks {
+		err := s.commitTxRetry(chunk.opts, chunk.builders)
+		if err != nil {
+			failed = append(failed, chunk.indexes...)
+			result = multierror.Append(result, err)
+		}
+	}
+	return failed, result
+}
+
+func buildTxOps(builders []txBuilder) (consul.TxnOps, error) {
+	opts := make(consul.TxnOps, 0)
+	for _, builder := range builders {
+		builderOpts, err := builder()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, builderOpts...)
+	}
+	return opts, nil
+}
+
+func isHistoryWrite(opt *consul.TxnOp) bool {
+	return opt.KV != nil && opt.KV.Verb == consul.KVCAS && strings.HasPrefix(opt.KV.Key, config.ConsulKVHistoryPrefix)
+}
@@ -0,0 +1,140 @@
+package gslb
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/mux"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/audit"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/contexes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// signalWebhookBody is the json body expected by signal webhook.
+type signalWebhookBody struct {
+	// degraded or failed, it is required
+	Level  string `json:"level"`
+	Reason string `json:"reason"`
+	// go duration, e.g. 5m
+	Duration string `json:"duration"`
+}
+
+// SignalWebhook lets external systems which can not use grpc report member signals over http,
+// calls are authenticated with basic auth and audited as ReportMemberSignal calls.
+type SignalWebhook struct {
+	server  *Server
+	auth    *config.BasicAuth
+	auditor *audit.Auditor
+}
+
+func NewSignalWebhook(server *Server, auth *config.BasicAuth, auditor *audit.Auditor) *SignalWebhook {
+	return &SignalWebhook{
+		server:  server,
+		auth:    auth,
+		auditor: auditor,
+	}
+}
+
+func (h *SignalWebhook) checkAuth(req *http.Request) (string, bool) {
+	username, password, ok := req.BasicAuth()
+	if !ok {
+		return "", false
+	}
+	usernameHash := sha256.Sum256([]byte(username))
+	passwordHash := sha256.Sum256([]byte(password))
+	expectedUsernameHash := sha256.Sum256([]byte(h.auth.Username))
+	expectedPasswordHash := sha256.Sum256([]byte(h.auth.Password))
+
+	usernameMatch := (subtle.ConstantTimeCompare(usernameHash[:], expectedUsernameHash[:]) == 1)
+	passwordMatch := (subtle.ConstantTimeCompare(passwordHash[:], expectedPasswordHash[:]) == 1)
+
+	return username, usernameMatch && passwordMatch
+}
+
+func (h *SignalWebhook) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	username, ok := h.checkAuth(req)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	body := &signalWebhookBody{}
+	err := json.NewDecoder(req.Body).Decode(body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid body: %v", err), http.StatusBadRequest)
+		return
+	}
+	if body.Level == "" {
+		http.Error(w, "level is required", http.StatusBadRequest)
+		return
+	}
+	levelValue, ok := opssvc.MemberSignal_Level_value[strings.ToUpper(body.Level)]
+	if !ok || levelValue == int32(opssvc.MemberSignal_LEVEL_UNSPECIFIED) {
+		http.Error(w, fmt.Sprintf("invalid level %s", body.Level), http.StatusBadRequest)
+		return
+	}
+	level := opssvc.MemberSignal_Level(levelValue)
+	duration, err := time.ParseDuration(body.Duration)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid duration: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	vars := mux.Vars(req)
+	request := &opssvc.ReportMemberSignalRequest{
+		Fqdn:     vars["fqdn"],
+		Ip:       vars["ip"],
+		Level:    level,
+		Reason:   body.Reason,
+		Duration: durationpb.New(duration),
+	}
+	ctx := contexes.SetCaller(req.Context(), "webhook:"+username)
+	resp, err := h.report(ctx, request)
+	if err != nil {
+		http.Error(w, err.Error(), httpStatusFromError(err))
+		return
+	}
+	b, err := protojson.Marshal(resp.(*opssvc.MemberSignal))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(b) // nolint:errcheck
+}
+
+func (h *SignalWebhook) report(ctx context.Context, request *opssvc.ReportMemberSignalRequest) (any, error) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return h.server.ReportMemberSignal(ctx, req.(*opssvc.ReportMemberSignalRequest))
+	}
+	if h.auditor == nil {
+		return handler(ctx, request)
+	}
+	return h.auditor.UnaryServerInterceptor()(ctx, request, &grpc.UnaryServerInfo{
+		FullMethod: opssvc.Ops_ReportMemberSignal_FullMethodName,
+	}, handler)
+}
+
+func httpStatusFromError(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,28 @@
+package gslb
+
+import (
+	"context"
+	"github.com/miekg/dns"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ListEffectiveWeights(ctx context.Context, request *opssvc.ListEffectiveWeightsRequest) (*opssvc.ListEffectiveWeightsResponse, error) {
+	err := request.ValidateAll()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	fqdn := ""
+	if request.GetFqdn() != "" {
+		fqdn = dns.CanonicalName(request.GetFqdn())
+	}
+	weights, err := s.gslocConsul.ListEffectiveWeights(fqdn)
+	if err != nil {
+		return nil, err
+	}
+	return &opssvc.ListEffectiveWeightsResponse{
+		Weights: weights,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package healthchecks
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/ArthurHlt/gohc"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	hcconf "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/healthchecks/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// cachedChecker is a health checker built for an entry, checkers keep their http clients
+// which let connections being reused across checks.
+type cachedChecker struct {
+	signature string
+	hcDef     *hcconf.HealthCheck
+	checker   gohc.HealthChecker
+}
+
+// protoSignature gives signature of health check definition or policy, it is the only signature used
+// to cache checkers whatever the way definition has been received.
+func protoSignature(msg proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{
+		Deterministic: true,
+	}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// cachedCheckerFor gives checker cached for fqdn if it has been made from same signature and tls policy,
+// otherwise a new checker is made from definition given by makeDef and cached.
+func (h *HcHandler) cachedCheckerFor(fqdn, signature string, makeDef func() (*hcconf.HealthCheck, error)) (*cachedChecker, error) {
+	tlsPolicy := h.retriever.GetPolicy(fqdn).GetHealth().GetTls()
+	tlsSignature, err := protoSignature(tlsPolicy)
+	if err != nil {
+		return nil, err
+	}
+	signature = signature + "+" + tlsSignature
+	if raw, ok := h.checkers.Load(fqdn); ok {
+		cached := raw.(*cachedChecker)
+		if cached.signature == signature {
+			return cached, nil
+		}
+	}
+	hcDef, err := makeDef()
+	if err != nil {
+		return nil, err
+	}
+	clientTls, err := MakeClientTls(tlsPolicy, h.cnf.SecretsDir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tls policy: %w", err)
+	}
+	checker, err := MakeHealthCheck(hcDef, fqdn, h.checkEnv(fqdn, clientTls))
+	if err != nil {
+		return nil, err
+	}
+	cached := &cachedChecker{
+		signature: signature,
+		hcDef:     hcDef,
+		checker:   checker,
+	}
+	h.checkers.Store(fqdn, cached)
+	return cached, nil
+}
+
+// SetKVEntry invalidates cached checker of entry, it will be made again on next check.
+// State of members removed from entry is forgotten.
+func (h *HcHandler) SetKVEntry(entry *entries.SignedEntry) {
+	h.checkers.Delete(entry.GetEntry().GetFqdn())
+	ips := make(map[string]struct{})
+	dcs := make(map[string]string)
+	for _, member := range entry.GetEntry().GetMembersIpv4() {
+		ips[member.GetIp()] = struct{}{}
+		dcs[member.GetIp()] = member.GetDc()
+	}
+	for _, member := range entry.GetEntry().GetMembersIpv6() {
+		ips[member.GetIp()] = struct{}{}
+		dcs[member.GetIp()] = member.GetDc()
+	}
+	h.tracker.retain(entry.GetEntry().GetFqdn(), ips)
+	h.memberDcs.Store(entry.GetEntry().GetFqdn(), dcs)
+}
+
+func (h *HcHandler) RemoveKvEntry(entry *entries.SignedEntry) {
+	h.checkers.Delete(entry.GetEntry().GetFqdn())
+	h.memberDcs.Delete(entry.GetEntry().GetFqdn())
+	h.tracker.forget(entry.GetEntry().GetFqdn())
+	stats.RemoveEntry(entry.GetEntry().GetFqdn())
+}
+
+// checkEnv gives environment to make health checks of entry fqdn.
+func (h *HcHandler) checkEnv(fqdn string, clientTls *ClientTls) *CheckEnv {
+	return &CheckEnv{
+		Plugins:         h.plugins,
+		ClientTls:       clientTls,
+		PromQLEndpoints: h.cnf.PromQLEndpoints,
+		MemberDc: func(ip string) string {
+			return h.memberDc(fqdn, ip)
+		},
+	}
+}
+
+// memberDc gives dc of a member of entry fqdn, dc of this node is given for unknown members.
+func (h *HcHandler) memberDc(fqdn, ip string) string {
+	rawDcs, ok := h.memberDcs.Load(fqdn)
+	if !ok {
+		return h.dcName
+	}
+	dc, ok := rawDcs.(map[string]string)[ip]
+	if !ok || dc == "" {
+		return h.dcName
+	}
+	return dc
+}
@@ -0,0 +1,201 @@
+package healthchecks
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ArthurHlt/gohc"
+	hcconf "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/healthchecks/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"google.golang.org/protobuf/encoding/protojson"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	CompositeModeAll    = "all"
+	CompositeModeAny    = "any"
+	CompositeModeQuorum = "quorum"
+)
+
+// DetailedChecker is implemented by health checkers giving details on probe even when it succeeds.
+type DetailedChecker interface {
+	CheckWithDetails(host string) (string, error)
+}
+
+// CompositeDef is the definition of a composite health check given in options of a composite plugin health check.
+type CompositeDef struct {
+	Mode string
+	// number of checks which must pass, it is set for all modes
+	Quorum int
+	Checks []*hcconf.HealthCheck
+}
+
+// ParseCompositeDef makes composite definition from options of a composite plugin health check.
+// Options are mode (all, any or quorum, default to all), quorum (number of checks which must pass in quorum mode)
+// and checks (list of health checks, they can not be composite).
+func ParseCompositeDef(options map[string]any) (*CompositeDef, error) {
+	def := &CompositeDef{
+		Mode: CompositeModeAll,
+	}
+	rawChecks, ok := options["checks"].([]any)
+	if !ok || len(rawChecks) == 0 {
+		return nil, fmt.Errorf("composite checks must be a non empty list of health checks")
+	}
+	for i, rawCheck := range rawChecks {
+		b, err := json.Marshal(rawCheck)
+		if err != nil {
+			return nil, err
+		}
+		hcDef := &hcconf.HealthCheck{}
+		err = protojson.Unmarshal(b, hcDef)
+		if err != nil {
+			return nil, fmt.Errorf("composite check %d: %w", i, err)
+		}
+		err = hcDef.ValidateAll()
+		if err != nil {
+			return nil, fmt.Errorf("composite check %d: %w", i, err)
+		}
+		if hcDef.GetPluginHealthCheck().GetName() == config.CompositeHealthCheckPluginName {
+			return nil, fmt.Errorf("composite check %d: composite checks can not be nested", i)
+		}
+		def.Checks = append(def.Checks, hcDef)
+	}
+
+	if rawMode, ok := options["mode"]; ok {
+		mode, ok := rawMode.(string)
+		if !ok {
+			return nil, fmt.Errorf("composite mode must be a string")
+		}
+		def.Mode = strings.ToLower(mode)
+	}
+	switch def.Mode {
+	case CompositeModeAll:
+		def.Quorum = len(def.Checks)
+	case CompositeModeAny:
+		def.Quorum = 1
+	case CompositeModeQuorum:
+		quorum, err := parseQuorum(options["quorum"])
+		if err != nil {
+			return nil, err
+		}
+		if quorum < 1 || quorum > len(def.Checks) {
+			return nil, fmt.Errorf("composite quorum must be between 1 and %d", len(def.Checks))
+		}
+		def.Quorum = quorum
+	default:
+		return nil, fmt.Errorf("composite mode must be %s, %s or %s", CompositeModeAll, CompositeModeAny, CompositeModeQuorum)
+	}
+	for key := range options {
+		if key != "mode" && key != "quorum" && key != "checks" {
+			return nil, fmt.Errorf("unknown composite option %s", key)
+		}
+	}
+	return def, nil
+}
+
+func parseQuorum(rawQuorum any) (int, error) {
+	switch q := rawQuorum.(type) {
+	case float64:
+		if q != float64(int(q)) {
+			return 0, fmt.Errorf("composite quorum must be an integer")
+		}
+		return int(q), nil
+	case string:
+		return strconv.Atoi(q)
+	case nil:
+		return 0, fmt.Errorf("composite quorum must be set in %s mode", CompositeModeQuorum)
+	}
+	return 0, fmt.Errorf("composite quorum must be an integer")
+}
+
+type compositeSubCheck struct {
+	name    string
+	port    uint32
+	checker gohc.HealthChecker
+}
+
+// CompositeHealthCheck runs several health checks on a member and passes when quorum of them pass.
+type CompositeHealthCheck struct {
+	quorum    int
+	subChecks []*compositeSubCheck
+}
+
+func NewCompositeHealthCheck(def *CompositeDef, fqdn string, env *CheckEnv) (*CompositeHealthCheck, error) {
+	subChecks := make([]*compositeSubCheck, len(def.Checks))
+	for i, hcDef := range def.Checks {
+		checker, err := MakeHealthCheck(hcDef, fqdn, env)
+		if err != nil {
+			return nil, fmt.Errorf("composite check %d: %w", i, err)
+		}
+		subChecks[i] = &compositeSubCheck{
+			name:    fmt.Sprintf("%s:%d", checkerTypeName(hcDef), hcDef.GetPort()),
+			port:    hcDef.GetPort(),
+			checker: checker,
+		}
+	}
+	return &CompositeHealthCheck{
+		quorum:    def.Quorum,
+		subChecks: subChecks,
+	}, nil
+}
+
+func (h *CompositeHealthCheck) Check(host string) error {
+	_, err := h.CheckWithDetails(host)
+	return err
+}
+
+// CheckWithDetails runs all sub checks concurrently on their own port and gives result of each of them.
+func (h *CompositeHealthCheck) CheckWithDetails(host string) (string, error) {
+	ip := host
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		ip = host[:i]
+	}
+	errs := make([]error, len(h.subChecks))
+	wg := &sync.WaitGroup{}
+	for i, subCheck := range h.subChecks {
+		i, subCheck := i, subCheck
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = subCheck.checker.Check(fmt.Sprintf("%s:%d", ip, subCheck.port))
+		}()
+	}
+	wg.Wait()
+
+	passed := 0
+	results := make([]string, len(h.subChecks))
+	for i, subCheck := range h.subChecks {
+		if errs[i] != nil {
+			results[i] = fmt.Sprintf("%s failed: %v", subCheck.name, errs[i])
+			continue
+		}
+		passed++
+		results[i] = fmt.Sprintf("%s ok", subCheck.name)
+	}
+	details := fmt.Sprintf("%d/%d checks passed, %d needed [%s]", passed, len(h.subChecks), h.quorum, strings.Join(results, "; "))
+	if passed < h.quorum {
+		return "", fmt.Errorf("%s", details)
+	}
+	return details, nil
+}
+
+func checkerTypeName(hcDef *hcconf.HealthCheck) string {
+	switch hcDef.GetHealthChecker().(type) {
+	case *hcconf.HealthCheck_GrpcHealthCheck:
+		return "grpc"
+	case *hcconf.HealthCheck_HttpHealthCheck:
+		return "http"
+	case *hcconf.HealthCheck_TcpHealthCheck:
+		return "tcp"
+	case *hcconf.HealthCheck_IcmpHealthCheck:
+		return "icmp"
+	case *hcconf.HealthCheck_UdpHealthCheck:
+		return "udp"
+	case *hcconf.HealthCheck_NoHealthCheck:
+		return "no"
+	case *hcconf.HealthCheck_PluginHealthCheck:
+		return hcDef.GetPluginHealthCheck().GetName()
+	}
+	return "unknown"
+}
@@ -0,0 +1,86 @@
+package healthchecks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DcHealth is health of a member seen by a dc.
+type DcHealth struct {
+	Dc      string
+	Healthy bool
+	Output  string
+}
+
+// CrossDcResult is health of a member decided by dcs which probed it.
+type CrossDcResult struct {
+	Healthy   bool
+	Unhealthy int
+	// number of dcs which must see member unhealthy, it is lowered to number of dcs with a known health
+	Quorum int
+	Dcs    []DcHealth
+}
+
+// String gives a summary of result, health seen by each dc is given by GetMemberHealthHistory.
+func (r *CrossDcResult) String() string {
+	unhealthyDcs := make([]string, 0)
+	for _, dcHealth := range r.Dcs {
+		if !dcHealth.Healthy {
+			unhealthyDcs = append(unhealthyDcs, dcHealth.Dc)
+		}
+	}
+	if len(unhealthyDcs) == 0 {
+		return fmt.Sprintf("[cross dc 0/%d unhealthy, quorum %d]", len(r.Dcs), r.Quorum)
+	}
+	return fmt.Sprintf("[cross dc %d/%d unhealthy, quorum %d: %s]", r.Unhealthy, len(r.Dcs), r.Quorum, strings.Join(unhealthyDcs, ", "))
+}
+
+// evaluateCrossDc decides health of a member from health seen by this dc and reports not expired of other dcs.
+// When fewer dcs than quorum gave their health, member is unhealthy if all of them see it unhealthy.
+func (h *HcHandler) evaluateCrossDc(fqdn, ip string, quorum uint32, local *ProbeResult) *CrossDcResult {
+	result := &CrossDcResult{
+		Dcs: []DcHealth{
+			{
+				Dc:      h.dcName,
+				Healthy: local.Healthy,
+			},
+		},
+	}
+	now := time.Now()
+	reports := h.retriever.GetDcHealthReports(fqdn)
+	dcs := make([]string, 0, len(reports))
+	for dc := range reports {
+		dcs = append(dcs, dc)
+	}
+	sort.Strings(dcs)
+	for _, dc := range dcs {
+		report := reports[dc]
+		if dc == h.dcName || now.After(report.GetExpiresAt().AsTime()) {
+			continue
+		}
+		for _, member := range report.GetMembers() {
+			if member.GetIp() != ip {
+				continue
+			}
+			result.Dcs = append(result.Dcs, DcHealth{
+				Dc:      dc,
+				Healthy: member.GetHealthy(),
+				Output:  member.GetOutput(),
+			})
+			break
+		}
+	}
+	for _, dcHealth := range result.Dcs {
+		if !dcHealth.Healthy {
+			result.Unhealthy++
+		}
+	}
+	result.Quorum = int(quorum)
+	if result.Quorum > len(result.Dcs) {
+		result.Quorum = len(result.Dcs)
+	}
+	result.Healthy = result.Unhealthy < result.Quorum
+	return result
+}
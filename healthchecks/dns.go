@@ -0,0 +1,135 @@
+package healthchecks
+
+import (
+	"crypto/tls"
+	"fmt"
+	"github.com/miekg/dns"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	DnsProtocolUDP = "udp"
+	DnsProtocolTCP = "tcp"
+)
+
+// DnsOpt describes a dns health check, member is expected to be a dns server answering query.
+type DnsOpt struct {
+	// Name to query, entry fqdn is used if empty
+	QueryName string
+	QueryType uint16
+	// Protocol is udp or tcp, tcp with tls is used when TlsConfig is set
+	Protocol      string
+	ExpectedRcode int
+	// AnswerRegex must match at least one answer record if set
+	AnswerRegex *regexp.Regexp
+	// AnswerContains must be found in at least one answer record if set
+	AnswerContains string
+	// Timeout of the query. If left empty (default to 5s)
+	Timeout   time.Duration
+	TlsConfig *tls.Config
+}
+
+type DnsHealthCheck struct {
+	opt    *DnsOpt
+	client *dns.Client
+}
+
+func NewDnsHealthCheck(opt *DnsOpt) *DnsHealthCheck {
+	timeout := opt.Timeout
+	if timeout == 0 {
+		timeout = 5 * time.Second
+	}
+	net := opt.Protocol
+	if opt.TlsConfig != nil {
+		net = "tcp-tls"
+	}
+	return &DnsHealthCheck{
+		opt: opt,
+		client: &dns.Client{
+			Net:       net,
+			Timeout:   timeout,
+			TLSConfig: opt.TlsConfig,
+		},
+	}
+}
+
+func (h *DnsHealthCheck) Check(host string) error {
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(h.opt.QueryName), h.opt.QueryType)
+	resp, _, err := h.client.Exchange(msg, host)
+	if err != nil {
+		return err
+	}
+	if resp.Rcode != h.opt.ExpectedRcode {
+		return fmt.Errorf("expected rcode %s, got %s", dns.RcodeToString[h.opt.ExpectedRcode], dns.RcodeToString[resp.Rcode])
+	}
+	if h.opt.AnswerRegex == nil && h.opt.AnswerContains == "" {
+		return nil
+	}
+	for _, rr := range resp.Answer {
+		answer := rr.String()
+		if h.opt.AnswerRegex != nil && !h.opt.AnswerRegex.MatchString(answer) {
+			continue
+		}
+		if h.opt.AnswerContains != "" && !strings.Contains(answer, h.opt.AnswerContains) {
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("no answer matching expectations found in %d answers", len(resp.Answer))
+}
+
+// MakeDnsOpt makes dns health check options from options of a dns plugin health check.
+// Options are query_name, query_type, protocol, expected_rcode, expected_answer_regex and expected_answer_contains.
+func MakeDnsOpt(options map[string]any, fqdn string) (*DnsOpt, error) {
+	opt := &DnsOpt{
+		QueryName:     fqdn,
+		QueryType:     dns.TypeA,
+		Protocol:      DnsProtocolUDP,
+		ExpectedRcode: dns.RcodeSuccess,
+	}
+	for key, value := range options {
+		strValue, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("dns option %s must be a string", key)
+		}
+		switch key {
+		case "query_name":
+			opt.QueryName = strValue
+		case "query_type":
+			qType, ok := dns.StringToType[strings.ToUpper(strValue)]
+			if !ok {
+				return nil, fmt.Errorf("unknown dns query type %s", strValue)
+			}
+			opt.QueryType = qType
+		case "protocol":
+			strValue = strings.ToLower(strValue)
+			if strValue != DnsProtocolUDP && strValue != DnsProtocolTCP {
+				return nil, fmt.Errorf("dns protocol must be %s or %s", DnsProtocolUDP, DnsProtocolTCP)
+			}
+			opt.Protocol = strValue
+		case "expected_rcode":
+			rcode, ok := dns.StringToRcode[strings.ToUpper(strValue)]
+			if !ok {
+				return nil, fmt.Errorf("unknown dns rcode %s", strValue)
+			}
+			opt.ExpectedRcode = rcode
+		case "expected_answer_regex":
+			rgx, err := regexp.Compile(strValue)
+			if err != nil {
+				return nil, fmt.Errorf("invalid expected_answer_regex: %w", err)
+			}
+			opt.AnswerRegex = rgx
+		case "expected_answer_contains":
+			opt.AnswerContains = strValue
+		default:
+			return nil, fmt.Errorf("unknown dns option %s", key)
+		}
+	}
+	if opt.QueryName == "" {
+		return nil, fmt.Errorf("dns query_name must be set")
+	}
+	return opt, nil
+}
@@ -1,8 +1,10 @@
 package healthchecks
 
 import (
+	"context"
 	"crypto/sha256"
 	"crypto/subtle"
+	"errors"
 	"fmt"
 	"github.com/gorilla/mux"
 	hcconf "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/healthchecks/v1"
@@ -13,20 +15,52 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 )
 
+var ErrDisabledMember = errors.New("disabled entry")
+
 type HcHandler struct {
 	disabledEntIp *sync.Map
+	checkers      *sync.Map
+	memberDcs     *sync.Map
+	tracker       *healthTracker
+	limiter       *probeLimiter
+	plugins       *Plugins
+	retriever     StateRetriever
+	dcName        string
 	cnf           *config.HealthCheckConfig
 }
 
-func NewHcHandler(cnf *config.HealthCheckConfig) *HcHandler {
+func NewHcHandler(cnf *config.HealthCheckConfig, dcName string, retriever StateRetriever) *HcHandler {
 	return &HcHandler{
 		disabledEntIp: &sync.Map{},
+		checkers:      &sync.Map{},
+		memberDcs:     &sync.Map{},
+		tracker:       newHealthTracker(cnf.ProbeHistorySize),
+		limiter:       newProbeLimiter(cnf.MaxConcurrentProbes, cnf.MaxConcurrentProbesPerIp, time.Duration(*cnf.ProbeQueueTimeout)),
+		plugins:       NewPlugins(cnf.Plugins),
+		retriever:     retriever,
+		dcName:        dcName,
 		cnf:           cnf,
 	}
 }
 
+// Run keeps persistent plugins running until context is done.
+func (h *HcHandler) Run(ctx context.Context) {
+	h.plugins.Run(ctx)
+}
+
+// Plugins gives plugin health checks used by handler.
+func (h *HcHandler) Plugins() *Plugins {
+	return h.plugins
+}
+
+// PromQLEndpoints gives prometheus endpoints which can be queried by promql health checks.
+func (h *HcHandler) PromQLEndpoints() []*config.PromQLEndpointConfig {
+	return h.cnf.PromQLEndpoints
+}
+
 func (h *HcHandler) DisableEntryIp(fqdn, ip string) {
 	log.Tracef(fmt.Sprintf("Disabling %s-%s", fqdn, ip))
 	h.disabledEntIp.Store(fmt.Sprintf("%s-%s", fqdn, ip), struct{}{})
@@ -92,29 +126,136 @@ func (h *HcHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	_, isDisabled := h.disabledEntIp.Load(fmt.Sprintf("%s-%s", fqdn, ip))
-	if isDisabled {
-		http.Error(w, "disabled entry", http.StatusGone)
+	if h.IsDisabled(fqdn, ip) {
+		http.Error(w, ErrDisabledMember.Error(), http.StatusGone)
 		return
 	}
 
+	// definition is signed as proto as grpc checks do, json given by consul would make another cached checker
 	hcDef := &hcconf.HealthCheck{}
 	err = protojson.Unmarshal(b, hcDef)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	signature, err := protoSignature(hcDef)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-
-	hcker, err := MakeHealthCheck(hcDef, fqdn, h.cnf.Plugins)
+	cached, err := h.cachedCheckerFor(fqdn, signature, func() (*hcconf.HealthCheck, error) {
+		return hcDef, nil
+	})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	host := fmt.Sprintf("%s:%d", ip, hcDef.GetPort())
-	err = hcker.Check(host)
+
+	// output is given in body to let consul keep it in check output
+	result, err := h.probe(fqdn, ip, cached, true)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusExpectationFailed)
+		// consul set check as warning on 429 instead of critical
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+	if !result.Healthy {
+		http.Error(w, result.String(), http.StatusExpectationFailed)
 		return
 	}
 	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(result.String()))
+}
+
+func (h *HcHandler) IsDisabled(fqdn, ip string) bool {
+	_, isDisabled := h.disabledEntIp.Load(fmt.Sprintf("%s-%s", fqdn, ip))
+	return isDisabled
+}
+
+// Check runs health check hcDef against member ip of entry fqdn and gives member state according to entry health policy,
+// an error is returned only when check can not be made, ErrProbeRejected when too many probes are in flight. Member must be in the dc of this node,
+// health seen by other dcs is taken into account when entry uses cross dc quorum.
+func (h *HcHandler) Check(fqdn, ip string, hcDef *hcconf.HealthCheck) (*ProbeResult, error) {
+	return h.check(fqdn, ip, hcDef, true)
+}
+
+// CheckRemote runs health check hcDef against member ip of entry fqdn which is in another dc,
+// only health seen by this node is given.
+func (h *HcHandler) CheckRemote(fqdn, ip string, hcDef *hcconf.HealthCheck) (*ProbeResult, error) {
+	return h.check(fqdn, ip, hcDef, false)
+}
+
+func (h *HcHandler) check(fqdn, ip string, hcDef *hcconf.HealthCheck, withCrossDc bool) (*ProbeResult, error) {
+	signature, err := protoSignature(hcDef)
+	if err != nil {
+		return nil, err
+	}
+	cached, err := h.cachedCheckerFor(fqdn, signature, func() (*hcconf.HealthCheck, error) {
+		return hcDef, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return h.probe(fqdn, ip, cached, withCrossDc)
+}
+
+func (h *HcHandler) probe(fqdn, ip string, cached *cachedChecker, withCrossDc bool) (*ProbeResult, error) {
+	release, err := h.limiter.acquire(ip)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	start := time.Now()
+	details, err := cached.check(ip)
+	duration := time.Since(start)
+	// dc is the one from which probe is made
+	stats.ObserveProbe(fqdn, h.dcName, duration, err)
+	healthPolicy := h.retriever.GetPolicy(fqdn).GetHealth()
+	result := h.tracker.record(fqdn, ip, healthPolicy, ProbeRecord{
+		Timestamp: start,
+		Duration:  duration,
+		Err:       err,
+	})
+	result.Details = details
+	if withCrossDc && healthPolicy.GetCrossDcQuorum() > 0 {
+		result.CrossDc = h.evaluateCrossDc(fqdn, ip, healthPolicy.GetCrossDcQuorum(), result)
+		result.Healthy = result.CrossDc.Healthy
+	}
+	return result, nil
+}
+
+// History gives last probes made by this node by ip of members of entry fqdn, newest first,
+// with state of members resulting from them. Only member ip is given if set.
+// State of members of this node dc is decided with health seen by other dcs when entry uses cross dc quorum.
+func (h *HcHandler) History(fqdn, ip string) map[string]*MemberHistory {
+	healthPolicy := h.retriever.GetPolicy(fqdn).GetHealth()
+	histories := h.tracker.history(fqdn, ip, healthPolicy)
+	if healthPolicy.GetCrossDcQuorum() == 0 {
+		return histories
+	}
+	for memberIp, history := range histories {
+		if h.memberDc(fqdn, memberIp) != h.dcName {
+			continue
+		}
+		history.State.CrossDc = h.evaluateCrossDc(fqdn, memberIp, healthPolicy.GetCrossDcQuorum(), history.State)
+		history.State.Healthy = history.State.CrossDc.Healthy
+	}
+	return histories
+}
+
+// ProbeJitter gives maximum random delay added between scheduled probes of a member.
+func (h *HcHandler) ProbeJitter() time.Duration {
+	return time.Duration(*h.cnf.ProbeJitter)
+}
+
+// DcName gives dc of this node.
+func (h *HcHandler) DcName() string {
+	return h.dcName
+}
+
+func (c *cachedChecker) check(ip string) (string, error) {
+	host := fmt.Sprintf("%s:%d", ip, c.hcDef.GetPort())
+	if detailed, ok := c.checker.(DetailedChecker); ok {
+		return detailed.CheckWithDetails(host)
+	}
+	return "", c.checker.Check(host)
 }
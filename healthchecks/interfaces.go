@@ -10,12 +10,25 @@ import (
 	gsloctype "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/type/v1"
 	"github.com/orange-cloudfoundry/gsloc/config"
 	"net/http"
+	"time"
 )
 
-func MakeHealthCheck(hcDef *hcconf.HealthCheck, fqdn string, plugins []*config.PluginHealthCheckConfig) (gohc.HealthChecker, error) {
+// CheckEnv gives what is needed to make health checks of an entry beside their definition.
+type CheckEnv struct {
+	Plugins *Plugins
+	// ClientTls is given by entry policy, it is used when tls is enabled
+	ClientTls       *ClientTls
+	PromQLEndpoints []*config.PromQLEndpointConfig
+	// MemberDc gives dc of a member of entry from its ip
+	MemberDc func(ip string) string
+}
+
+// MakeHealthCheck makes health checker from definition for members of entry fqdn.
+func MakeHealthCheck(hcDef *hcconf.HealthCheck, fqdn string, env *CheckEnv) (gohc.HealthChecker, error) {
+	clientTls := env.ClientTls
 	var hchecker gohc.HealthChecker
 	tlsEnable := hcDef.GetTlsConfig().GetEnable()
-	tlsConf := makeTlsConfig(hcDef.GetTlsConfig(), fqdn)
+	tlsConf := makeTlsConfig(hcDef.GetTlsConfig(), fqdn, clientTls)
 	switch hcDef.GetHealthChecker().(type) {
 	case *hcconf.HealthCheck_GrpcHealthCheck:
 		hchecker = gohc.NewGrpcHealthCheck(&gohc.GrpcOpt{
@@ -63,13 +76,30 @@ func MakeHealthCheck(hcDef *hcconf.HealthCheck, fqdn string, plugins []*config.P
 	case *hcconf.HealthCheck_NoHealthCheck:
 		hchecker = gohc.NewNoHealthCheck()
 	case *hcconf.HealthCheck_PluginHealthCheck:
-		var foundPlugin *config.PluginHealthCheckConfig
-		for _, plugin := range plugins {
-			if hcDef.GetPluginHealthCheck().Name == plugin.Name {
-				foundPlugin = plugin
-				break
+		switch hcDef.GetPluginHealthCheck().GetName() {
+		case config.DnsHealthCheckPluginName:
+			dnsOpt, err := MakeDnsOpt(hcDef.GetPluginHealthCheck().GetOptions().AsMap(), fqdn)
+			if err != nil {
+				return nil, err
+			}
+			dnsOpt.Timeout = hcDef.GetTimeout().AsDuration()
+			dnsOpt.TlsConfig = tlsConf
+			return NewDnsHealthCheck(dnsOpt), nil
+		case config.CompositeHealthCheckPluginName:
+			compositeDef, err := ParseCompositeDef(hcDef.GetPluginHealthCheck().GetOptions().AsMap())
+			if err != nil {
+				return nil, err
 			}
+			return NewCompositeHealthCheck(compositeDef, fqdn, env)
+		case config.PromQLHealthCheckPluginName:
+			promQLOpt, err := MakePromQLOpt(hcDef.GetPluginHealthCheck().GetOptions().AsMap())
+			if err != nil {
+				return nil, err
+			}
+			promQLOpt.Timeout = hcDef.GetTimeout().AsDuration()
+			return NewPromQLHealthCheck(promQLOpt, fqdn, env.PromQLEndpoints, env.MemberDc)
 		}
+		foundPlugin, persistent := env.Plugins.find(hcDef.GetPluginHealthCheck().GetName())
 		if foundPlugin == nil {
 			return nil, fmt.Errorf("plugin %s not found", hcDef.GetPluginHealthCheck().GetName())
 		}
@@ -77,18 +107,41 @@ func MakeHealthCheck(hcDef *hcconf.HealthCheck, fqdn string, plugins []*config.P
 		if hcDef.GetTlsConfig().GetCa() != "" {
 			cas = append(cas, hcDef.GetTlsConfig().GetCa())
 		}
-		hchecker = gohc.NewProgramHealthCheck(&gohc.ProgramOpt{
-			Path:       foundPlugin.Path,
-			Args:       foundPlugin.Args,
-			Options:    hcDef.GetPluginHealthCheck().GetOptions().AsMap(),
-			Timeout:    hcDef.GetTimeout().AsDuration(),
-			TlsEnabled: tlsEnable,
-			ProgramTlsConfig: &gohc.ProgramTlsOpt{
-				InsecureSkipVerify: false,
-				ServerName:         hcDef.GetTlsConfig().GetServerName(),
-				RootCAs:            cas,
+		timeout := hcDef.GetTimeout().AsDuration()
+		if timeout == 0 {
+			timeout = 5 * time.Second
+		}
+		check := &pluginCheck{
+			TimeoutSeconds: int64(timeout.Seconds()),
+			Options:        hcDef.GetPluginHealthCheck().GetOptions().AsMap(),
+			TlsEnabled:     tlsEnable,
+			TlsConfig: &pluginTls{
+				ServerName: hcDef.GetTlsConfig().GetServerName(),
+				RootCAs:    cas,
 			},
-		})
+		}
+		if tlsEnable && clientTls != nil {
+			check.TlsConfig.ClientCert = clientTls.CertPEM
+			check.TlsConfig.ClientKey = clientTls.KeyPEM
+			check.TlsConfig.MinVersion = clientTls.MinVersionName
+			check.TlsConfig.CipherSuites = clientTls.CipherSuiteNames
+		}
+		if persistent != nil {
+			return &PersistentPluginHealthCheck{
+				plugin:  persistent,
+				timeout: timeout,
+				check:   check,
+			}, nil
+		}
+		return &ProgramHealthCheck{
+			path:    foundPlugin.Path,
+			args:    foundPlugin.Args,
+			timeout: timeout,
+			check:   check,
+		}, nil
+	}
+	if hchecker == nil {
+		return nil, fmt.Errorf("unsupported health check type %T", hcDef.GetHealthChecker())
 	}
 	return hchecker, nil
 }
@@ -136,7 +189,7 @@ func makeGohcExpectedStatus(rn *gsloctype.Int64Range) *gohc.IntRange {
 	}
 }
 
-func makeTlsConfig(tlsConf *hcconf.TlsConfig, fqdn string) *tls.Config {
+func makeTlsConfig(tlsConf *hcconf.TlsConfig, fqdn string, clientTls *ClientTls) *tls.Config {
 	if tlsConf == nil || !tlsConf.Enable {
 		return nil
 	}
@@ -152,8 +205,10 @@ func makeTlsConfig(tlsConf *hcconf.TlsConfig, fqdn string) *tls.Config {
 		caCertPool = x509.NewCertPool()
 		caCertPool.AppendCertsFromPEM([]byte(tlsConf.GetCa()))
 	}
-	return &tls.Config{
+	conf := &tls.Config{
 		RootCAs:    caCertPool,
 		ServerName: serverName,
 	}
+	clientTls.apply(conf)
+	return conf
 }
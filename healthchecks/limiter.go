@@ -0,0 +1,94 @@
+package healthchecks
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrProbeRejected is given when a probe waited too long for a slot, it says nothing about member health.
+var ErrProbeRejected = errors.New("probe rejected")
+
+const (
+	limitGlobal = "global"
+	limitIp     = "ip"
+)
+
+type ipSlots struct {
+	sem   chan struct{}
+	users int
+}
+
+// probeLimiter bounds probes made by this node, globally and by destination ip.
+type probeLimiter struct {
+	global       chan struct{}
+	perIp        int
+	queueTimeout time.Duration
+	mu           sync.Mutex
+	ips          map[string]*ipSlots
+}
+
+func newProbeLimiter(maxProbes, maxProbesPerIp int, queueTimeout time.Duration) *probeLimiter {
+	return &probeLimiter{
+		global:       make(chan struct{}, maxProbes),
+		perIp:        maxProbesPerIp,
+		queueTimeout: queueTimeout,
+		ips:          make(map[string]*ipSlots),
+	}
+}
+
+// acquire waits for a slot to probe ip, release must be called when probe is done.
+func (l *probeLimiter) acquire(ip string) (release func(), err error) {
+	stats.AddQueuedProbes(1)
+	defer stats.AddQueuedProbes(-1)
+
+	slots := l.ipSlotsFor(ip)
+	timer := time.NewTimer(l.queueTimeout)
+	defer timer.Stop()
+	select {
+	case slots.sem <- struct{}{}:
+	case <-timer.C:
+		l.releaseIp(ip, slots)
+		stats.AddRejectedProbe(limitIp)
+		return nil, fmt.Errorf("%w: %d probes already in flight on %s", ErrProbeRejected, l.perIp, ip)
+	}
+	select {
+	case l.global <- struct{}{}:
+	case <-timer.C:
+		<-slots.sem
+		l.releaseIp(ip, slots)
+		stats.AddRejectedProbe(limitGlobal)
+		return nil, fmt.Errorf("%w: %d probes already in flight", ErrProbeRejected, cap(l.global))
+	}
+	stats.AddInFlightProbes(1)
+	return func() {
+		stats.AddInFlightProbes(-1)
+		<-l.global
+		<-slots.sem
+		l.releaseIp(ip, slots)
+	}, nil
+}
+
+func (l *probeLimiter) ipSlotsFor(ip string) *ipSlots {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	slots, ok := l.ips[ip]
+	if !ok {
+		slots = &ipSlots{
+			sem: make(chan struct{}, l.perIp),
+		}
+		l.ips[ip] = slots
+	}
+	slots.users++
+	return slots
+}
+
+func (l *probeLimiter) releaseIp(ip string, slots *ipSlots) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	slots.users--
+	if slots.users == 0 {
+		delete(l.ips, ip)
+	}
+}
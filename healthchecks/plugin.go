@@ -0,0 +1,377 @@
+package healthchecks
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ArthurHlt/gohc"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+const (
+	pluginRequestCheck    = "check"
+	pluginRequestMetadata = "metadata"
+
+	pluginMinBackoff = time.Second
+	pluginMaxBackoff = 30 * time.Second
+	// a plugin running longer than this is considered as healthy and its restart backoff is reset
+	pluginStableRun = time.Minute
+	// max size of a line written by a plugin
+	pluginMaxLineSize = 1024 * 1024
+)
+
+// PluginMetadata is given by persistent plugins about themselves.
+type PluginMetadata struct {
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+// pluginCheck is given as json to plugins, non-persistent plugins receive it on stdin.
+type pluginCheck struct {
+	Host           string         `json:"host"`
+	TimeoutSeconds int64          `json:"timeout_seconds"`
+	Options        map[string]any `json:"options"`
+	TlsEnabled     bool           `json:"tls_enabled"`
+	TlsConfig      *pluginTls     `json:"tls_config"`
+}
+
+type pluginTls struct {
+	InsecureSkipVerify bool     `json:"insecure_skip_verify"`
+	ServerName         string   `json:"server_name"`
+	RootCAs            []string `json:"root_cas"`
+	// client identity and tls policy given by entry health policy, pem encoded
+	ClientCert   string   `json:"client_cert,omitempty"`
+	ClientKey    string   `json:"client_key,omitempty"`
+	MinVersion   string   `json:"min_version,omitempty"`
+	CipherSuites []string `json:"cipher_suites,omitempty"`
+}
+
+// pluginRequest is written as a json line on plugin stdin.
+type pluginRequest struct {
+	ID    uint64       `json:"id"`
+	Type  string       `json:"type"`
+	Check *pluginCheck `json:"check,omitempty"`
+}
+
+// pluginResponse is read as a json line on plugin stdout, plugin can answer requests in any order.
+type pluginResponse struct {
+	ID       uint64          `json:"id"`
+	Success  bool            `json:"success"`
+	Error    string          `json:"error"`
+	Metadata *PluginMetadata `json:"metadata"`
+}
+
+// Plugins gives plugin health checks configured, persistent ones are started once and restarted if they crash.
+type Plugins struct {
+	configs     []*config.PluginHealthCheckConfig
+	persistents map[string]*persistentPlugin
+}
+
+func NewPlugins(configs []*config.PluginHealthCheckConfig) *Plugins {
+	persistents := make(map[string]*persistentPlugin)
+	for _, cnf := range configs {
+		if !cnf.Persistent {
+			continue
+		}
+		persistents[cnf.Name] = newPersistentPlugin(cnf)
+	}
+	return &Plugins{
+		configs:     configs,
+		persistents: persistents,
+	}
+}
+
+// Run starts persistent plugins and keep them running until context is done.
+func (p *Plugins) Run(ctx context.Context) {
+	wg := &sync.WaitGroup{}
+	for _, plugin := range p.persistents {
+		plugin := plugin
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			plugin.run(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// Metadata gives metadata reported by a persistent plugin, nil is returned if not known.
+func (p *Plugins) Metadata(name string) *PluginMetadata {
+	plugin, ok := p.persistents[name]
+	if !ok {
+		return nil
+	}
+	return plugin.getMetadata()
+}
+
+func (p *Plugins) find(name string) (*config.PluginHealthCheckConfig, *persistentPlugin) {
+	for _, cnf := range p.configs {
+		if cnf.Name == name {
+			return cnf, p.persistents[name]
+		}
+	}
+	return nil, nil
+}
+
+type persistentPlugin struct {
+	cnf   *config.PluginHealthCheckConfig
+	entry *log.Entry
+	sem   chan struct{}
+	mu    sync.Mutex
+	stdin io.Writer
+	// writeMu serializes requests written to stdin, it is never held with mu
+	// as a write blocked by a busy plugin would prevent its responses from being read
+	writeMu  sync.Mutex
+	pending  map[uint64]chan *pluginResponse
+	nextID   uint64
+	metadata *PluginMetadata
+}
+
+func newPersistentPlugin(cnf *config.PluginHealthCheckConfig) *persistentPlugin {
+	return &persistentPlugin{
+		cnf:     cnf,
+		entry:   log.WithField("component", "plugin").WithField("plugin", cnf.Name),
+		sem:     make(chan struct{}, cnf.MaxConcurrency),
+		pending: make(map[uint64]chan *pluginResponse),
+	}
+}
+
+func (p *persistentPlugin) run(ctx context.Context) {
+	backoff := pluginMinBackoff
+	for {
+		start := time.Now()
+		err := p.runOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		stats.AddPluginRestart(p.cnf.Name)
+		if time.Since(start) > pluginStableRun {
+			backoff = pluginMinBackoff
+		}
+		p.entry.WithError(err).Errorf("plugin exited, restarting in %s", backoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > pluginMaxBackoff {
+			backoff = pluginMaxBackoff
+		}
+	}
+}
+
+func (p *persistentPlugin) runOnce(ctx context.Context) error {
+	cmd := exec.CommandContext(ctx, p.cnf.Path, p.cnf.Args...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+	err = cmd.Start()
+	if err != nil {
+		return err
+	}
+	p.entry.Info("plugin started")
+
+	p.mu.Lock()
+	p.stdin = stdin
+	p.mu.Unlock()
+
+	go p.logStderr(stderr)
+	go p.fetchMetadata()
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 0, 64*1024), pluginMaxLineSize)
+	for scanner.Scan() {
+		resp := &pluginResponse{}
+		err := json.Unmarshal(scanner.Bytes(), resp)
+		if err != nil {
+			p.entry.WithError(err).Warnf("invalid response from plugin: %s", scanner.Text())
+			continue
+		}
+		p.mu.Lock()
+		respChan, ok := p.pending[resp.ID]
+		delete(p.pending, resp.ID)
+		p.mu.Unlock()
+		if ok {
+			respChan <- resp
+		}
+	}
+	scanErr := scanner.Err()
+
+	// fail requests in flight, plugin will not answer them anymore
+	p.mu.Lock()
+	p.stdin = nil
+	for id, respChan := range p.pending {
+		respChan <- &pluginResponse{
+			ID:    id,
+			Error: "plugin exited",
+		}
+		delete(p.pending, id)
+	}
+	p.mu.Unlock()
+	_ = stdin.Close()
+
+	err = cmd.Wait()
+	if err == nil {
+		err = scanErr
+	}
+	if err == nil {
+		err = fmt.Errorf("plugin closed its output")
+	}
+	return err
+}
+
+func (p *persistentPlugin) logStderr(stderr io.Reader) {
+	scanner := bufio.NewScanner(stderr)
+	for scanner.Scan() {
+		p.entry.Warn(scanner.Text())
+	}
+}
+
+func (p *persistentPlugin) fetchMetadata() {
+	resp, err := p.send(&pluginRequest{
+		Type: pluginRequestMetadata,
+	}, 5*time.Second)
+	if err != nil {
+		p.entry.WithError(err).Warn("unable to get plugin metadata")
+		return
+	}
+	p.mu.Lock()
+	p.metadata = resp.Metadata
+	p.mu.Unlock()
+}
+
+func (p *persistentPlugin) getMetadata() *PluginMetadata {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.metadata
+}
+
+// send a request to plugin and wait for its response, at most max concurrency requests are in flight.
+func (p *persistentPlugin) send(request *pluginRequest, timeout time.Duration) (*pluginResponse, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case p.sem <- struct{}{}:
+		defer func() { <-p.sem }()
+	case <-timer.C:
+		stats.AddPluginRejected(p.cnf.Name)
+		return nil, fmt.Errorf("plugin %s is busy, %d requests already in flight", p.cnf.Name, cap(p.sem))
+	}
+
+	respChan := make(chan *pluginResponse, 1)
+	p.mu.Lock()
+	if p.stdin == nil {
+		p.mu.Unlock()
+		return nil, fmt.Errorf("plugin %s is not running", p.cnf.Name)
+	}
+	p.nextID++
+	request.ID = p.nextID
+	b, err := json.Marshal(request)
+	if err != nil {
+		p.mu.Unlock()
+		return nil, err
+	}
+	p.pending[request.ID] = respChan
+	stdin := p.stdin
+	p.mu.Unlock()
+
+	p.writeMu.Lock()
+	_, err = stdin.Write(append(b, '\n'))
+	p.writeMu.Unlock()
+	if err != nil {
+		p.mu.Lock()
+		delete(p.pending, request.ID)
+		p.mu.Unlock()
+		return nil, fmt.Errorf("unable to send request to plugin %s: %w", p.cnf.Name, err)
+	}
+
+	select {
+	case resp := <-respChan:
+		return resp, nil
+	case <-timer.C:
+		p.mu.Lock()
+		delete(p.pending, request.ID)
+		p.mu.Unlock()
+		return nil, fmt.Errorf("plugin %s did not answer in %s", p.cnf.Name, timeout)
+	}
+}
+
+// PersistentPluginHealthCheck sends checks to a persistent plugin instead of starting the plugin on each check.
+type PersistentPluginHealthCheck struct {
+	plugin  *persistentPlugin
+	check   *pluginCheck
+	timeout time.Duration
+}
+
+func (h *PersistentPluginHealthCheck) Check(host string) error {
+	host, err := gohc.FormatHost(host, 0)
+	if err != nil {
+		return err
+	}
+	check := *h.check
+	check.Host = host
+	resp, err := h.plugin.send(&pluginRequest{
+		Type:  pluginRequestCheck,
+		Check: &check,
+	}, h.timeout)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("plugin health check failed: %s", resp.Error)
+	}
+	return nil
+}
+
+// ProgramHealthCheck starts plugin on each check and gives it check as json on stdin,
+// check pass if plugin exits successfully.
+type ProgramHealthCheck struct {
+	path    string
+	args    []string
+	check   *pluginCheck
+	timeout time.Duration
+}
+
+func (h *ProgramHealthCheck) Check(host string) error {
+	host, err := gohc.FormatHost(host, 0)
+	if err != nil {
+		return err
+	}
+	check := *h.check
+	check.Host = host
+	dataJson, err := json.Marshal(&check)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), h.timeout)
+	defer cancelFunc()
+
+	cmd := exec.CommandContext(ctx, h.path, h.args...)
+	output := &bytes.Buffer{}
+	cmd.Stderr = output
+	cmd.Stdout = output
+	cmd.Stdin = bytes.NewBuffer(dataJson)
+	err = cmd.Run()
+	if err != nil {
+		return fmt.Errorf("program health check failed: %s, output from program: %s", err.Error(), output.String())
+	}
+	return nil
+}
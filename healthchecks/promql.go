@@ -0,0 +1,306 @@
+package healthchecks
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const (
+	PromQLEmptyPass = "pass"
+	PromQLEmptyFail = "fail"
+)
+
+var promQLOperators = map[string]func(value, threshold float64) bool{
+	">":  func(value, threshold float64) bool { return value > threshold },
+	">=": func(value, threshold float64) bool { return value >= threshold },
+	"<":  func(value, threshold float64) bool { return value < threshold },
+	"<=": func(value, threshold float64) bool { return value <= threshold },
+	"==": func(value, threshold float64) bool { return value == threshold },
+	"!=": func(value, threshold float64) bool { return value != threshold },
+}
+
+// PromQLOpt describes a promql health check, member fails when a value given by query crosses threshold.
+type PromQLOpt struct {
+	// Endpoint is the name of a configured prometheus endpoint, first one is used if empty
+	Endpoint string
+	// Query is templated with .Fqdn, .Ip and .Dc of member
+	Query *template.Template
+	// Operator tells when check fails: value <Operator> Threshold
+	Operator  string
+	Threshold float64
+	// OnEmpty is pass or fail, it gives result when query gives no value
+	OnEmpty string
+	// Timeout of the query. If left empty (default to 5s)
+	Timeout time.Duration
+}
+
+// promQLVars are variables given to query template.
+type promQLVars struct {
+	Fqdn string
+	Ip   string
+	Dc   string
+}
+
+func MakePromQLOpt(options map[string]any) (*PromQLOpt, error) {
+	opt := &PromQLOpt{
+		Operator: ">",
+		OnEmpty:  PromQLEmptyFail,
+	}
+	thresholdSet := false
+	for key, value := range options {
+		if key == "threshold" {
+			threshold, err := promQLThreshold(value)
+			if err != nil {
+				return nil, err
+			}
+			opt.Threshold = threshold
+			thresholdSet = true
+			continue
+		}
+		strValue, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("promql option %s must be a string", key)
+		}
+		switch key {
+		case "endpoint":
+			opt.Endpoint = strValue
+		case "query":
+			tpl, err := template.New("query").Option("missingkey=error").Parse(strValue)
+			if err != nil {
+				return nil, fmt.Errorf("invalid promql query template: %w", err)
+			}
+			opt.Query = tpl
+		case "operator":
+			if _, ok := promQLOperators[strValue]; !ok {
+				return nil, fmt.Errorf("unknown promql operator %s", strValue)
+			}
+			opt.Operator = strValue
+		case "on_empty":
+			strValue = strings.ToLower(strValue)
+			if strValue != PromQLEmptyPass && strValue != PromQLEmptyFail {
+				return nil, fmt.Errorf("promql on_empty must be %s or %s", PromQLEmptyPass, PromQLEmptyFail)
+			}
+			opt.OnEmpty = strValue
+		default:
+			return nil, fmt.Errorf("unknown promql option %s", key)
+		}
+	}
+	if opt.Query == nil {
+		return nil, fmt.Errorf("promql query must be set")
+	}
+	if !thresholdSet {
+		return nil, fmt.Errorf("promql threshold must be set")
+	}
+	return opt, nil
+}
+
+func promQLThreshold(value any) (float64, error) {
+	switch t := value.(type) {
+	case float64:
+		return t, nil
+	case string:
+		threshold, err := strconv.ParseFloat(t, 64)
+		if err != nil {
+			return 0, fmt.Errorf("promql threshold must be a number")
+		}
+		return threshold, nil
+	}
+	return 0, fmt.Errorf("promql threshold must be a number")
+}
+
+type PromQLHealthCheck struct {
+	opt      *PromQLOpt
+	endpoint *config.PromQLEndpointConfig
+	fqdn     string
+	memberDc func(ip string) string
+	client   *http.Client
+}
+
+// NewPromQLHealthCheck makes a promql health check for members of entry fqdn, memberDc gives dc of a member.
+func NewPromQLHealthCheck(opt *PromQLOpt, fqdn string, endpoints []*config.PromQLEndpointConfig, memberDc func(ip string) string) (*PromQLHealthCheck, error) {
+	var endpoint *config.PromQLEndpointConfig
+	for _, e := range endpoints {
+		if opt.Endpoint == "" || e.Name == opt.Endpoint {
+			endpoint = e
+			break
+		}
+	}
+	if endpoint == nil {
+		if opt.Endpoint == "" {
+			return nil, fmt.Errorf("no promql endpoint configured")
+		}
+		return nil, fmt.Errorf("promql endpoint %s not found", opt.Endpoint)
+	}
+	timeout := opt.Timeout
+	if timeout == 0 {
+		timeout = 5 * time.Second
+	}
+	return &PromQLHealthCheck{
+		opt:      opt,
+		endpoint: endpoint,
+		fqdn:     strings.TrimSuffix(fqdn, "."),
+		memberDc: memberDc,
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: endpoint.InsecureSkipVerify, // nolint:gosec
+				},
+			},
+		},
+	}, nil
+}
+
+func (h *PromQLHealthCheck) Check(host string) error {
+	_, err := h.CheckWithDetails(host)
+	return err
+}
+
+// CheckWithDetails runs query for member and gives values found.
+func (h *PromQLHealthCheck) CheckWithDetails(host string) (string, error) {
+	ip, _, err := net.SplitHostPort(host)
+	if err != nil {
+		ip = host
+	}
+	buf := &bytes.Buffer{}
+	err = h.opt.Query.Execute(buf, promQLVars{
+		Fqdn: h.fqdn,
+		Ip:   ip,
+		Dc:   h.memberDc(ip),
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to render promql query: %w", err)
+	}
+	query := buf.String()
+
+	values, err := h.query(query)
+	if err != nil {
+		return "", err
+	}
+	if len(values) == 0 {
+		if h.opt.OnEmpty == PromQLEmptyPass {
+			return fmt.Sprintf("query %s gave no value", query), nil
+		}
+		return "", fmt.Errorf("query %s gave no value", query)
+	}
+	crosses := promQLOperators[h.opt.Operator]
+	for _, value := range values {
+		if crosses(value, h.opt.Threshold) {
+			return "", fmt.Errorf("query %s gave %g, fails when %s %g", query, value, h.opt.Operator, h.opt.Threshold)
+		}
+	}
+	return fmt.Sprintf("query %s gave %s, fails when %s %g", query, formatValues(values), h.opt.Operator, h.opt.Threshold), nil
+}
+
+type promQLResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// query runs an instant query and gives values of samples found.
+func (h *PromQLHealthCheck) query(query string) ([]float64, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet,
+		strings.TrimSuffix(h.endpoint.URL, "/")+"/api/v1/query?"+url.Values{"query": []string{query}}.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if h.endpoint.BasicAuth != nil {
+		req.SetBasicAuth(h.endpoint.BasicAuth.Username, h.endpoint.BasicAuth.Password)
+	}
+	if h.endpoint.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+h.endpoint.BearerToken)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("promql endpoint %s: %w", h.endpoint.Name, err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("promql endpoint %s: %w", h.endpoint.Name, err)
+	}
+	promResp := &promQLResponse{}
+	err = json.Unmarshal(b, promResp)
+	if err != nil {
+		return nil, fmt.Errorf("promql endpoint %s answered with status %d: invalid response: %w", h.endpoint.Name, resp.StatusCode, err)
+	}
+	if promResp.Status != "success" {
+		return nil, fmt.Errorf("promql endpoint %s: %s: %s", h.endpoint.Name, promResp.ErrorType, promResp.Error)
+	}
+	return parsePromQLResult(promResp.Data.ResultType, promResp.Data.Result)
+}
+
+func parsePromQLResult(resultType string, result json.RawMessage) ([]float64, error) {
+	switch resultType {
+	case "scalar":
+		var sample []any
+		err := json.Unmarshal(result, &sample)
+		if err != nil {
+			return nil, fmt.Errorf("invalid promql scalar result: %w", err)
+		}
+		value, err := promQLSampleValue(sample)
+		if err != nil {
+			return nil, err
+		}
+		return []float64{value}, nil
+	case "vector":
+		var samples []struct {
+			Value []any `json:"value"`
+		}
+		err := json.Unmarshal(result, &samples)
+		if err != nil {
+			return nil, fmt.Errorf("invalid promql vector result: %w", err)
+		}
+		values := make([]float64, len(samples))
+		for i, sample := range samples {
+			values[i], err = promQLSampleValue(sample.Value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("promql result type %s is not supported, query must give a scalar or an instant vector", resultType)
+}
+
+// promQLSampleValue gives value of a sample given as [timestamp, "value"].
+func promQLSampleValue(sample []any) (float64, error) {
+	if len(sample) != 2 {
+		return 0, fmt.Errorf("invalid promql sample")
+	}
+	strValue, ok := sample[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("invalid promql sample value")
+	}
+	value, err := strconv.ParseFloat(strValue, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid promql sample value: %w", err)
+	}
+	return value, nil
+}
+
+func formatValues(values []float64) string {
+	strValues := make([]string, len(values))
+	for i, value := range values {
+		strValues[i] = strconv.FormatFloat(value, 'g', -1, 64)
+	}
+	return strings.Join(strValues, ", ")
+}
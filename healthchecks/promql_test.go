@@ -0,0 +1,206 @@
+package healthchecks
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/onsi/gomega"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// fakePrometheus answers instant queries with a vector of given values and records queries received.
+type fakePrometheus struct {
+	mu      sync.Mutex
+	values  []string
+	queries []string
+}
+
+func (f *fakePrometheus) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if req.URL.Path != "/api/v1/query" {
+		http.NotFound(w, req)
+		return
+	}
+	f.queries = append(f.queries, req.URL.Query().Get("query"))
+	result := make([]map[string]any, len(f.values))
+	for i, value := range f.values {
+		result[i] = map[string]any{
+			"metric": map[string]string{},
+			"value":  []any{1700000000.0, value},
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"status": "success",
+		"data": map[string]any{
+			"resultType": "vector",
+			"result":     result,
+		},
+	})
+}
+
+func (f *fakePrometheus) setValues(values ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.values = values
+}
+
+func (f *fakePrometheus) lastQuery() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.queries) == 0 {
+		return ""
+	}
+	return f.queries[len(f.queries)-1]
+}
+
+func newTestPromQLHealthCheck(t *testing.T, prom *fakePrometheus, options map[string]any) *PromQLHealthCheck {
+	t.Helper()
+	server := httptest.NewServer(prom)
+	t.Cleanup(server.Close)
+	opt, err := MakePromQLOpt(options)
+	if err != nil {
+		t.Fatalf("make promql options: %v", err)
+	}
+	hc, err := NewPromQLHealthCheck(opt, "app.example.com.", []*config.PromQLEndpointConfig{
+		{
+			Name: "test",
+			URL:  server.URL,
+		},
+	}, func(ip string) string {
+		if ip == "10.0.0.1" {
+			return "dc1"
+		}
+		return ""
+	})
+	if err != nil {
+		t.Fatalf("make promql health check: %v", err)
+	}
+	return hc
+}
+
+func TestPromQLHealthCheckOperators(t *testing.T) {
+	cases := []struct {
+		operator string
+		value    string
+		fails    bool
+	}{
+		{operator: ">", value: "11", fails: true},
+		{operator: ">", value: "10", fails: false},
+		{operator: ">=", value: "10", fails: true},
+		{operator: ">=", value: "9.5", fails: false},
+		{operator: "<", value: "9", fails: true},
+		{operator: "<", value: "10", fails: false},
+		{operator: "<=", value: "10", fails: true},
+		{operator: "<=", value: "10.5", fails: false},
+		{operator: "==", value: "10", fails: true},
+		{operator: "==", value: "11", fails: false},
+		{operator: "!=", value: "11", fails: true},
+		{operator: "!=", value: "10", fails: false},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%s %s", c.operator, c.value), func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			prom := &fakePrometheus{}
+			prom.setValues(c.value)
+			hc := newTestPromQLHealthCheck(t, prom, map[string]any{
+				"query":     "up",
+				"operator":  c.operator,
+				"threshold": float64(10),
+			})
+			err := hc.Check("10.0.0.1:80")
+			if c.fails {
+				g.Expect(err).To(gomega.HaveOccurred())
+				g.Expect(err.Error()).To(gomega.ContainSubstring("fails when %s 10", c.operator))
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+	}
+}
+
+func TestPromQLHealthCheckFailsWhenAnyValueCrosses(t *testing.T) {
+	g := gomega.NewWithT(t)
+	prom := &fakePrometheus{}
+	prom.setValues("1", "20", "3")
+	hc := newTestPromQLHealthCheck(t, prom, map[string]any{
+		"query":     "up",
+		"threshold": "10",
+	})
+	g.Expect(hc.Check("10.0.0.1:80")).To(gomega.MatchError(gomega.ContainSubstring("gave 20")))
+
+	prom.setValues("1", "2", "3")
+	details, err := hc.CheckWithDetails("10.0.0.1:80")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(details).To(gomega.ContainSubstring("gave 1, 2, 3"))
+}
+
+func TestPromQLHealthCheckOnEmpty(t *testing.T) {
+	cases := []struct {
+		onEmpty string
+		fails   bool
+	}{
+		{onEmpty: PromQLEmptyPass, fails: false},
+		{onEmpty: PromQLEmptyFail, fails: true},
+		// default is fail
+		{onEmpty: "", fails: true},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("on_empty %q", c.onEmpty), func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			prom := &fakePrometheus{}
+			options := map[string]any{
+				"query":     "up",
+				"threshold": float64(0),
+			}
+			if c.onEmpty != "" {
+				options["on_empty"] = c.onEmpty
+			}
+			hc := newTestPromQLHealthCheck(t, prom, options)
+			err := hc.Check("10.0.0.1:80")
+			if c.fails {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("gave no value")))
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+	}
+}
+
+func TestPromQLHealthCheckTemplateVars(t *testing.T) {
+	g := gomega.NewWithT(t)
+	prom := &fakePrometheus{}
+	prom.setValues("0")
+	hc := newTestPromQLHealthCheck(t, prom, map[string]any{
+		"query":     `node_load1{fqdn="{{ .Fqdn }}",instance="{{ .Ip }}",dc="{{ .Dc }}"}`,
+		"threshold": float64(1),
+	})
+	g.Expect(hc.Check("10.0.0.1:80")).To(gomega.Succeed())
+	g.Expect(prom.lastQuery()).To(gomega.Equal(`node_load1{fqdn="app.example.com",instance="10.0.0.1",dc="dc1"}`))
+}
+
+func TestMakePromQLOptInvalid(t *testing.T) {
+	cases := map[string]map[string]any{
+		"missing query":     {"threshold": float64(1)},
+		"missing threshold": {"query": "up"},
+		"unknown operator":  {"query": "up", "threshold": float64(1), "operator": "=~"},
+		"invalid on_empty":  {"query": "up", "threshold": float64(1), "on_empty": "skip"},
+		"invalid threshold": {"query": "up", "threshold": "high"},
+		"unknown option":    {"query": "up", "threshold": float64(1), "step": "1m"},
+		"invalid template":  {"query": "up{ip=\"{{ .Ip \"}", "threshold": float64(1)},
+	}
+	for name, options := range cases {
+		options := options
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			_, err := MakePromQLOpt(options)
+			g.Expect(err).To(gomega.HaveOccurred())
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package healthchecks
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"time"
+)
+
+var stats = metrics{
+	probeDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "gsloc",
+		Subsystem: "healthcheck",
+		Name:      "probe_duration_seconds",
+		Help:      "Duration of health check probes made on members",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{
+		"fqdn",
+		"dc",
+		"result",
+	}),
+
+	pluginRestarts: prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gsloc",
+		Subsystem: "healthcheck",
+		Name:      "plugin_restarts",
+		Help:      "Number of restarts of persistent plugins after they exited",
+	}, []string{
+		"plugin",
+	}),
+
+	pluginRejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gsloc",
+		Subsystem: "healthcheck",
+		Name:      "plugin_rejected_requests",
+		Help:      "Number of requests to persistent plugins rejected because too many were in flight",
+	}, []string{
+		"plugin",
+	}),
+
+	queuedProbes: prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "gsloc",
+		Subsystem: "healthcheck",
+		Name:      "probe_queue_depth",
+		Help:      "Number of probes waiting for a slot",
+	}),
+
+	inFlightProbes: prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "gsloc",
+		Subsystem: "healthcheck",
+		Name:      "probes_in_flight",
+		Help:      "Number of probes running",
+	}),
+
+	rejectedProbes: prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gsloc",
+		Subsystem: "healthcheck",
+		Name:      "rejected_probes",
+		Help:      "Number of probes rejected because they waited too long for a slot, by limit reached",
+	}, []string{
+		"limit",
+	}),
+}
+
+type metrics struct {
+	probeDuration  *prometheus.HistogramVec
+	pluginRestarts *prometheus.CounterVec
+	pluginRejected *prometheus.CounterVec
+	queuedProbes   prometheus.Gauge
+	inFlightProbes prometheus.Gauge
+	rejectedProbes *prometheus.CounterVec
+}
+
+func init() {
+	prometheus.MustRegister(stats.probeDuration)
+	prometheus.MustRegister(stats.pluginRestarts)
+	prometheus.MustRegister(stats.pluginRejected)
+	prometheus.MustRegister(stats.queuedProbes)
+	prometheus.MustRegister(stats.inFlightProbes)
+	prometheus.MustRegister(stats.rejectedProbes)
+}
+
+func (m *metrics) ObserveProbe(fqdn, dc string, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	m.probeDuration.WithLabelValues(fqdn, dc, result).Observe(duration.Seconds())
+}
+
+// RemoveEntry drops series of a removed entry.
+func (m *metrics) RemoveEntry(fqdn string) {
+	m.probeDuration.DeletePartialMatch(prometheus.Labels{"fqdn": fqdn})
+}
+
+func (m *metrics) AddPluginRestart(plugin string) {
+	m.pluginRestarts.WithLabelValues(plugin).Add(1)
+}
+
+func (m *metrics) AddPluginRejected(plugin string) {
+	m.pluginRejected.WithLabelValues(plugin).Add(1)
+}
+
+func (m *metrics) AddQueuedProbes(n float64) {
+	m.queuedProbes.Add(n)
+}
+
+func (m *metrics) AddInFlightProbes(n float64) {
+	m.inFlightProbes.Add(n)
+}
+
+func (m *metrics) AddRejectedProbe(limit string) {
+	m.rejectedProbes.WithLabelValues(limit).Add(1)
+}
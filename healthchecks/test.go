@@ -0,0 +1,61 @@
+package healthchecks
+
+import (
+	"fmt"
+	hcconf "github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/healthchecks/v1"
+	"sync"
+	"time"
+)
+
+// TestResult is the result of a health check tested on an ip.
+type TestResult struct {
+	Ip       string
+	Duration time.Duration
+	Err      error
+	Details  string
+}
+
+// Test runs health check hcDef now against all ips, health check is made as for entry fqdn but
+// it is not cached and results are not recorded.
+func (h *HcHandler) Test(fqdn string, hcDef *hcconf.HealthCheck, ips []string) ([]*TestResult, error) {
+	clientTls, err := MakeClientTls(h.retriever.GetPolicy(fqdn).GetHealth().GetTls(), h.cnf.SecretsDir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tls policy: %w", err)
+	}
+	checker, err := MakeHealthCheck(hcDef, fqdn, h.checkEnv(fqdn, clientTls))
+	if err != nil {
+		return nil, err
+	}
+	tested := &cachedChecker{
+		hcDef:   hcDef,
+		checker: checker,
+	}
+	results := make([]*TestResult, len(ips))
+	wg := &sync.WaitGroup{}
+	for i, ip := range ips {
+		i, ip := i, ip
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := h.limiter.acquire(ip)
+			if err != nil {
+				results[i] = &TestResult{
+					Ip:  ip,
+					Err: err,
+				}
+				return
+			}
+			defer release()
+			start := time.Now()
+			details, err := tested.check(ip)
+			results[i] = &TestResult{
+				Ip:       ip,
+				Duration: time.Since(start),
+				Err:      err,
+				Details:  details,
+			}
+		}()
+	}
+	wg.Wait()
+	return results, nil
+}
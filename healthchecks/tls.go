@@ -0,0 +1,171 @@
+package healthchecks
+
+import (
+	"crypto/tls"
+	"fmt"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const secretRefSecret = "secret:"
+
+var tlsVersions = map[string]uint16{
+	"TLS1.0": tls.VersionTLS10,
+	"TLS1.1": tls.VersionTLS11,
+	"TLS1.2": tls.VersionTLS12,
+	"TLS1.3": tls.VersionTLS13,
+}
+
+// ClientTls is tls material given by entry health policy and used by health checks when tls is enabled.
+type ClientTls struct {
+	Certificate *tls.Certificate
+	// pem of client certificate and key, given as is to plugins
+	CertPEM      string
+	KeyPEM       string
+	MinVersion   uint16
+	CipherSuites []uint16
+	// names of min version and cipher suites as given in policy, given as is to plugins
+	MinVersionName   string
+	CipherSuiteNames []string
+}
+
+func (c *ClientTls) apply(tlsConf *tls.Config) {
+	if c == nil || tlsConf == nil {
+		return
+	}
+	if c.Certificate != nil {
+		tlsConf.Certificates = []tls.Certificate{*c.Certificate}
+	}
+	tlsConf.MinVersion = c.MinVersion
+	tlsConf.CipherSuites = c.CipherSuites
+}
+
+// MakeClientTls resolves client tls policy, secret references are resolved in secretsDir. Nil is given for a nil policy.
+func MakeClientTls(policy *opssvc.HealthTlsPolicy, secretsDir string) (*ClientTls, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	err := ValidateHealthTlsPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+	clientTls := &ClientTls{
+		MinVersion:       tlsVersions[policy.GetMinVersion()],
+		MinVersionName:   policy.GetMinVersion(),
+		CipherSuiteNames: policy.GetCipherSuites(),
+	}
+	clientTls.CipherSuites, err = parseCipherSuites(policy.GetCipherSuites())
+	if err != nil {
+		return nil, err
+	}
+	if policy.GetClientCert() == "" {
+		return clientTls, nil
+	}
+	clientTls.CertPEM, err = resolveSecret(policy.GetClientCert(), secretsDir)
+	if err != nil {
+		return nil, fmt.Errorf("client cert: %w", err)
+	}
+	clientTls.KeyPEM, err = resolveSecret(policy.GetClientKey(), secretsDir)
+	if err != nil {
+		return nil, fmt.Errorf("client key: %w", err)
+	}
+	cert, err := tls.X509KeyPair([]byte(clientTls.CertPEM), []byte(clientTls.KeyPEM))
+	if err != nil {
+		return nil, fmt.Errorf("invalid client certificate: %w", err)
+	}
+	clientTls.Certificate = &cert
+	return clientTls, nil
+}
+
+// ValidateHealthTlsPolicy checks a client tls policy without resolving references which are only known by probing nodes.
+func ValidateHealthTlsPolicy(policy *opssvc.HealthTlsPolicy) error {
+	if (policy.GetClientCert() == "") != (policy.GetClientKey() == "") {
+		return fmt.Errorf("client cert and client key must be set together")
+	}
+	if policy.GetMinVersion() != "" {
+		if _, ok := tlsVersions[policy.GetMinVersion()]; !ok {
+			return fmt.Errorf("unknown tls version %s", policy.GetMinVersion())
+		}
+	}
+	_, err := parseCipherSuites(policy.GetCipherSuites())
+	if err != nil {
+		return err
+	}
+	if isSecretRef(policy.GetClientCert()) || isSecretRef(policy.GetClientKey()) {
+		for _, ref := range []string{policy.GetClientCert(), policy.GetClientKey()} {
+			if isSecretRef(ref) && !validSecretName(ref[len(secretRefSecret):]) {
+				return fmt.Errorf("invalid secret name in %s", ref)
+			}
+		}
+		return nil
+	}
+	if policy.GetClientCert() == "" {
+		return nil
+	}
+	_, err = tls.X509KeyPair([]byte(policy.GetClientCert()), []byte(policy.GetClientKey()))
+	if err != nil {
+		return fmt.Errorf("invalid client certificate: %w", err)
+	}
+	return nil
+}
+
+func parseCipherSuites(names []string) ([]uint16, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	known := make(map[string]uint16)
+	for _, suite := range tls.CipherSuites() {
+		known[suite.Name] = suite.ID
+	}
+	insecure := make(map[string]struct{})
+	for _, suite := range tls.InsecureCipherSuites() {
+		insecure[suite.Name] = struct{}{}
+	}
+	ids := make([]uint16, len(names))
+	for i, name := range names {
+		if _, ok := insecure[name]; ok {
+			return nil, fmt.Errorf("cipher suite %s is insecure and not allowed", name)
+		}
+		id, ok := known[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown cipher suite %s", name)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func isSecretRef(value string) bool {
+	return strings.HasPrefix(value, secretRefSecret)
+}
+
+func validSecretName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
+
+// resolveSecret gives content of value, value is either inline content or a reference to a file in secretsDir.
+// Path of a reference is checked to stay in secretsDir.
+func resolveSecret(value, secretsDir string) (string, error) {
+	if !isSecretRef(value) {
+		return value, nil
+	}
+	name := value[len(secretRefSecret):]
+	if secretsDir == "" {
+		return "", fmt.Errorf("secret %s can not be resolved, no secrets directory configured", name)
+	}
+	if !validSecretName(name) {
+		return "", fmt.Errorf("invalid secret name %s", name)
+	}
+	path := filepath.Join(secretsDir, name)
+	rel, err := filepath.Rel(secretsDir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("secret %s is outside of secrets directory", name)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,234 @@
+package healthchecks
+
+import (
+	"fmt"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"sync"
+	"time"
+)
+
+// OutputMarker starts output given by gsloc for a probe, it lets status readers find it in consul check output.
+const OutputMarker = "gsloc: "
+
+// StateRetriever gives policy set on an entry and health of its members seen by other dcs.
+type StateRetriever interface {
+	GetPolicy(fqdn string) *opssvc.EntryPolicy
+	GetDcHealthReports(fqdn string) map[string]*opssvc.DcHealthReport
+}
+
+// ProbeResult is the state of a member after a probe, member state only changes after enough
+// consecutive probes in the same state and is frozen while flapping is damped.
+type ProbeResult struct {
+	Healthy            bool
+	Successes          uint32
+	Failures           uint32
+	HealthyThreshold   uint32
+	UnhealthyThreshold uint32
+	DampedUntil        time.Time
+	// error of the last probe, nil if it succeeded
+	Err error
+	// details given by the last probe when it succeeded
+	Details string
+	// health seen by each dc when cross dc quorum is used, Healthy is then given by quorum
+	CrossDc *CrossDcResult
+}
+
+func (r *ProbeResult) String() string {
+	state := "healthy"
+	if !r.Healthy {
+		state = "unhealthy"
+	}
+	out := fmt.Sprintf("%s%s (successes %d/%d, failures %d/%d",
+		OutputMarker, state,
+		r.Successes, r.HealthyThreshold,
+		r.Failures, r.UnhealthyThreshold,
+	)
+	if !r.DampedUntil.IsZero() {
+		out += fmt.Sprintf(", damped until %s", r.DampedUntil.Format(time.RFC3339))
+	}
+	out += ")"
+	if r.CrossDc != nil {
+		out += " " + r.CrossDc.String()
+	}
+	if r.Err != nil {
+		out += ": " + r.Err.Error()
+	} else if r.Details != "" {
+		out += ": " + r.Details
+	}
+	return out
+}
+
+// ProbeRecord is the result of a single probe made on a member.
+type ProbeRecord struct {
+	Timestamp time.Time
+	Duration  time.Duration
+	Err       error
+}
+
+type memberState struct {
+	healthy     bool
+	successes   uint32
+	failures    uint32
+	flaps       []time.Time
+	dampedUntil time.Time
+	// last probes, oldest first
+	history []ProbeRecord
+}
+
+// healthTracker keeps state and last probes of members by entry and ip.
+type healthTracker struct {
+	mu          sync.Mutex
+	states      map[string]map[string]*memberState
+	historySize int
+}
+
+func newHealthTracker(historySize int) *healthTracker {
+	return &healthTracker{
+		states:      make(map[string]map[string]*memberState),
+		historySize: historySize,
+	}
+}
+
+func thresholdOrDefault(threshold uint32) uint32 {
+	if threshold == 0 {
+		return 1
+	}
+	return threshold
+}
+
+// record a probe made on member ip of entry fqdn and gives member state resulting from it.
+func (t *healthTracker) record(fqdn, ip string, policy *opssvc.HealthPolicy, probe ProbeRecord) *ProbeResult {
+	probeErr := probe.Err
+	now := probe.Timestamp
+	healthyThreshold := thresholdOrDefault(policy.GetHealthyThreshold())
+	unhealthyThreshold := thresholdOrDefault(policy.GetUnhealthyThreshold())
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.states[fqdn]; !ok {
+		t.states[fqdn] = make(map[string]*memberState)
+	}
+	state, ok := t.states[fqdn][ip]
+	if !ok {
+		// first probe gives state as is, there is no previous state to protect
+		state = &memberState{
+			healthy: probeErr == nil,
+		}
+		t.states[fqdn][ip] = state
+	}
+	state.history = append(state.history, probe)
+	if len(state.history) > t.historySize {
+		state.history = state.history[len(state.history)-t.historySize:]
+	}
+
+	if probeErr == nil {
+		state.successes++
+		state.failures = 0
+	} else {
+		state.failures++
+		state.successes = 0
+	}
+
+	wantHealthy := state.healthy
+	if !state.healthy && state.successes >= healthyThreshold {
+		wantHealthy = true
+	}
+	if state.healthy && state.failures >= unhealthyThreshold {
+		wantHealthy = false
+	}
+	if !now.Before(state.dampedUntil) {
+		state.dampedUntil = time.Time{}
+	}
+	if wantHealthy != state.healthy && state.dampedUntil.IsZero() {
+		state.healthy = wantHealthy
+		t.recordFlap(state, policy.GetFlapDamping(), now)
+	}
+
+	return &ProbeResult{
+		Healthy:            state.healthy,
+		Successes:          state.successes,
+		Failures:           state.failures,
+		HealthyThreshold:   healthyThreshold,
+		UnhealthyThreshold: unhealthyThreshold,
+		DampedUntil:        state.dampedUntil,
+		Err:                probeErr,
+	}
+}
+
+// recordFlap keeps state changes made in damping window and damp state when there is too many of them.
+func (t *healthTracker) recordFlap(state *memberState, damping *opssvc.FlapDamping, now time.Time) {
+	if damping.GetMaxFlaps() == 0 {
+		state.flaps = nil
+		return
+	}
+	window := damping.GetWindow().AsDuration()
+	flaps := make([]time.Time, 0, len(state.flaps)+1)
+	for _, flap := range state.flaps {
+		if now.Sub(flap) < window {
+			flaps = append(flaps, flap)
+		}
+	}
+	flaps = append(flaps, now)
+	state.flaps = flaps
+	if uint32(len(flaps)) < damping.GetMaxFlaps() {
+		return
+	}
+	suppress := damping.GetSuppress().AsDuration()
+	if suppress <= 0 {
+		suppress = window
+	}
+	state.dampedUntil = now.Add(suppress)
+	state.flaps = nil
+}
+
+// MemberHistory gives last probes made on a member, newest first, with member state resulting from them.
+type MemberHistory struct {
+	Probes []ProbeRecord
+	State  *ProbeResult
+}
+
+// history gives last probes and state by ip of members of entry fqdn. Only member ip is given if set.
+func (t *healthTracker) history(fqdn, ip string, policy *opssvc.HealthPolicy) map[string]*MemberHistory {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	histories := make(map[string]*MemberHistory)
+	for stateIp, state := range t.states[fqdn] {
+		if ip != "" && stateIp != ip {
+			continue
+		}
+		probes := make([]ProbeRecord, len(state.history))
+		for i, probe := range state.history {
+			probes[len(probes)-1-i] = probe
+		}
+		histories[stateIp] = &MemberHistory{
+			Probes: probes,
+			State: &ProbeResult{
+				Healthy:            state.healthy,
+				Successes:          state.successes,
+				Failures:           state.failures,
+				HealthyThreshold:   thresholdOrDefault(policy.GetHealthyThreshold()),
+				UnhealthyThreshold: thresholdOrDefault(policy.GetUnhealthyThreshold()),
+				DampedUntil:        state.dampedUntil,
+			},
+		}
+	}
+	return histories
+}
+
+// retain forgets state of members of entry fqdn which are not in ips.
+func (t *healthTracker) retain(fqdn string, ips map[string]struct{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for ip := range t.states[fqdn] {
+		if _, ok := ips[ip]; !ok {
+			delete(t.states[fqdn], ip)
+		}
+	}
+}
+
+// forget state of all members of entry fqdn.
+func (t *healthTracker) forget(fqdn string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.states, fqdn)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/entfiles"
+	"os"
+	"strings"
+)
+
+var importModes = map[string]opssvc.ImportOptions_Mode{
+	"merge":         opssvc.ImportOptions_MERGE,
+	"replace":       opssvc.ImportOptions_REPLACE,
+	"skip-existing": opssvc.ImportOptions_SKIP_EXISTING,
+}
+
+type ImportCmd struct {
+	ClientFlags `embed:""`
+	File        string   `arg:"" help:"yaml or json file containing entries to import" type:"existingfile"`
+	Mode        string   `short:"m" help:"merge set imported entries, replace also delete entries in scope not imported and skip-existing only create missing entries" enum:"merge,replace,skip-existing" default:"merge"`
+	Prefix      string   `help:"only import entries with fqdn starting with this prefix, in replace mode only delete those"`
+	Tags        []string `help:"only import entries having all these tags, in replace mode only delete those"`
+	DryRun      bool     `help:"only show what would be done"`
+}
+
+func (c *ImportCmd) Run() error {
+	signedEnts, err := entfiles.ReadFile(c.File)
+	if err != nil {
+		return err
+	}
+
+	client, conn, err := c.makeOpsClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	stream, err := client.ImportEntries(context.Background())
+	if err != nil {
+		return fmt.Errorf("import entries: %w", err)
+	}
+	err = stream.Send(&opssvc.ImportEntriesRequest{
+		Request: &opssvc.ImportEntriesRequest_Options{
+			Options: &opssvc.ImportOptions{
+				Mode:   importModes[c.Mode],
+				Prefix: c.Prefix,
+				Tags:   c.Tags,
+				DryRun: c.DryRun,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("import entries: %w", err)
+	}
+	for _, signedEnt := range signedEnts {
+		err = stream.Send(&opssvc.ImportEntriesRequest{
+			Request: &opssvc.ImportEntriesRequest_SignedEntry{
+				SignedEntry: signedEnt,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("import entries: %w", err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("import entries: %w", err)
+	}
+
+	if c.DryRun {
+		fmt.Fprintln(os.Stdout, "Dry run, nothing has been changed.")
+	}
+	printFqdns("created", resp.GetCreated())
+	printFqdns("updated", resp.GetUpdated())
+	printFqdns("deleted", resp.GetDeleted())
+	printFqdns("skipped", resp.GetSkipped())
+	printFqdns("failed", resp.GetFailed())
+	if len(resp.GetFailed()) > 0 {
+		return fmt.Errorf("import entries: %d entries have not been applied, they may have changed meanwhile", len(resp.GetFailed()))
+	}
+	return nil
+}
+
+func printFqdns(action string, fqdns []string) {
+	if len(fqdns) == 0 {
+		return
+	}
+	fmt.Fprintf(os.Stdout, "%s (%d):\n  %s\n", action, len(fqdns), strings.Join(fqdns, "\n  "))
+}
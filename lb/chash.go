@@ -0,0 +1,127 @@
+package lb
+
+import (
+	"context"
+	"fmt"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	"github.com/orange-cloudfoundry/gsloc/contexes"
+	"hash/fnv"
+	"math"
+	"net"
+)
+
+const (
+	defaultAffinityPrefixIpv4 = 24
+	defaultAffinityPrefixIpv6 = 56
+)
+
+// ConsistentHash makes clients of a same subnet always get the same member while it is active
+// by using weighted rendezvous hashing, only clients of a removed member are remapped
+// and adding a member only takes its share of clients from others.
+type ConsistentHash struct {
+	entry      *entries.Entry
+	allMembers []*entries.Member
+	prefixIpv4 int
+	prefixIpv6 int
+}
+
+// NewConsistentHash makes a consistent hash over client subnets of given prefix lengths, 0 gives /24 and /56.
+func NewConsistentHash(entry *entries.Entry, prefixIpv4, prefixIpv6 uint32) *ConsistentHash {
+	if prefixIpv4 == 0 || prefixIpv4 > 32 {
+		prefixIpv4 = defaultAffinityPrefixIpv4
+	}
+	if prefixIpv6 == 0 || prefixIpv6 > 128 {
+		prefixIpv6 = defaultAffinityPrefixIpv6
+	}
+	return &ConsistentHash{
+		entry:      entry,
+		allMembers: append(entry.GetMembersIpv4(), entry.GetMembersIpv6()...),
+		prefixIpv4: int(prefixIpv4),
+		prefixIpv6: int(prefixIpv6),
+	}
+}
+
+func (ch *ConsistentHash) Next(ctx context.Context, memberType MemberType) (*entries.Member, error) {
+	var members []*entries.Member
+	switch memberType {
+	case All:
+		members = ch.allMembers
+	case Ipv6:
+		members = ch.entry.GetMembersIpv6()
+	default:
+		members = ch.entry.GetMembersIpv4()
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+	if len(members) == 1 {
+		return members[0], nil
+	}
+	ecsMask, fromEcs := contexes.GetClientSubnetMask(ctx)
+	key := ch.clientSubnet(contexes.GetRemoteAddr(ctx), int(ecsMask), fromEcs)
+	if key == "" {
+		return nil, fmt.Errorf("no remote address found")
+	}
+	var best *entries.Member
+	bestScore := math.Inf(-1)
+	for _, member := range members {
+		score := rendezvousScore(key, member)
+		if score > bestScore {
+			best = member
+			bestScore = score
+		}
+	}
+	return best, nil
+}
+
+func (ch *ConsistentHash) Reset() error {
+	return nil
+}
+
+func (ch *ConsistentHash) Name() string {
+	return "consistent_hash"
+}
+
+// clientSubnet gives subnet of client address which is used as hash key, when client address is
+// a subnet given by edns client subnet its prefix length is used if it is smaller than the configured one.
+func (ch *ConsistentHash) clientSubnet(remoteAddr string, ecsMask int, fromEcs bool) string {
+	ip := net.ParseIP(remoteAddr)
+	if ip == nil {
+		return remoteAddr
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		prefix := ch.prefixIpv4
+		if fromEcs && ecsMask < prefix {
+			prefix = ecsMask
+		}
+		return fmt.Sprintf("%s/%d", ip4.Mask(net.CIDRMask(prefix, 32)), prefix)
+	}
+	prefix := ch.prefixIpv6
+	if fromEcs && ecsMask < prefix {
+		prefix = ecsMask
+	}
+	return fmt.Sprintf("%s/%d", ip.Mask(net.CIDRMask(prefix, 128)), prefix)
+}
+
+// rendezvousScore gives score of member for key, member with the highest score is chosen,
+// ratio of member is used as weight.
+func rendezvousScore(key string, member *entries.Member) float64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))            // nolint: errcheck
+	h.Write([]byte{0})              // nolint: errcheck
+	h.Write([]byte(member.GetIp())) // nolint: errcheck
+	// fnv alone gives close hashes for close keys, mix it to spread them
+	x := h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	// uniform value in ]0, 1[
+	u := (float64(x>>11) + 0.5) / (1 << 53)
+	weight := float64(member.GetRatio())
+	if weight == 0 {
+		weight = 1
+	}
+	return -weight / math.Log(u)
+}
@@ -0,0 +1,118 @@
+package lb
+
+import (
+	"context"
+	"fmt"
+	"github.com/onsi/gomega"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	"github.com/orange-cloudfoundry/gsloc/contexes"
+	"testing"
+)
+
+const chashTestSubnets = 20000
+
+func chashTestMembers(n int) []*entries.Member {
+	members := make([]*entries.Member, n)
+	for i := range members {
+		members[i] = &entries.Member{
+			Ip: fmt.Sprintf("192.168.0.%d", i+1),
+		}
+	}
+	return members
+}
+
+// chashAssign gives member ip chosen for each client /24 subnet.
+func chashAssign(t *testing.T, members []*entries.Member) []string {
+	t.Helper()
+	ch := NewConsistentHash(&entries.Entry{
+		Fqdn:        "chash.example.com.",
+		MembersIpv4: members,
+	}, 0, 0)
+	assigned := make([]string, chashTestSubnets)
+	for i := range assigned {
+		ctx := contexes.SetRemoteAddr(context.Background(), fmt.Sprintf("10.%d.%d.1", i/256, i%256))
+		member, err := ch.Next(ctx, Ipv4)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assigned[i] = member.GetIp()
+	}
+	return assigned
+}
+
+func TestConsistentHashRemovingMemberOnlyRemapsItsSubnets(t *testing.T) {
+	g := gomega.NewWithT(t)
+	const n = 10
+	members := chashTestMembers(n)
+	before := chashAssign(t, members)
+
+	removed := members[3].GetIp()
+	after := chashAssign(t, append(append([]*entries.Member{}, members[:3]...), members[4:]...))
+
+	remapped := 0
+	for i := range before {
+		if before[i] == after[i] {
+			continue
+		}
+		// only clients of removed member move
+		g.Expect(before[i]).To(gomega.Equal(removed))
+		remapped++
+	}
+	g.Expect(float64(remapped) / chashTestSubnets).To(gomega.BeNumerically("~", 1.0/n, 0.02))
+}
+
+func TestConsistentHashAddingMemberOnlyTakesItsShare(t *testing.T) {
+	g := gomega.NewWithT(t)
+	const n = 10
+	members := chashTestMembers(n + 1)
+	before := chashAssign(t, members[:n])
+	after := chashAssign(t, members)
+
+	added := members[n].GetIp()
+	remapped := 0
+	for i := range before {
+		if before[i] == after[i] {
+			continue
+		}
+		// moved clients only go to new member
+		g.Expect(after[i]).To(gomega.Equal(added))
+		remapped++
+	}
+	g.Expect(float64(remapped) / chashTestSubnets).To(gomega.BeNumerically("~", 1.0/(n+1), 0.02))
+}
+
+func TestConsistentHashSameSubnetSameMember(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ch := NewConsistentHash(&entries.Entry{
+		Fqdn:        "chash.example.com.",
+		MembersIpv4: chashTestMembers(10),
+	}, 0, 0)
+	next := func(ctx context.Context) string {
+		member, err := ch.Next(ctx, Ipv4)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		return member.GetIp()
+	}
+
+	for i := 0; i < 100; i++ {
+		first := next(contexes.SetRemoteAddr(context.Background(), fmt.Sprintf("10.1.%d.1", i)))
+		g.Expect(next(contexes.SetRemoteAddr(context.Background(), fmt.Sprintf("10.1.%d.254", i)))).To(gomega.Equal(first))
+	}
+}
+
+func TestConsistentHashClientSubnet(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ch := NewConsistentHash(&entries.Entry{}, 0, 0)
+
+	g.Expect(ch.clientSubnet("10.1.2.3", 0, false)).To(gomega.Equal("10.1.2.0/24"))
+	g.Expect(ch.clientSubnet("2001:db8:1:2:3::1", 0, false)).To(gomega.Equal("2001:db8:1::/56"))
+	// smaller subnet given by edns client subnet is used as is
+	g.Expect(ch.clientSubnet("10.1.0.0", 16, true)).To(gomega.Equal("10.1.0.0/16"))
+	g.Expect(ch.clientSubnet("2001:db8::", 48, true)).To(gomega.Equal("2001:db8::/48"))
+	// bigger one is capped by configured prefix
+	g.Expect(ch.clientSubnet("10.1.2.3", 32, true)).To(gomega.Equal("10.1.2.0/24"))
+	g.Expect(ch.clientSubnet("2001:db8:1:2:3::1", 128, true)).To(gomega.Equal("2001:db8:1::/56"))
+
+	custom := NewConsistentHash(&entries.Entry{}, 16, 48)
+	g.Expect(custom.clientSubnet("10.1.2.3", 0, false)).To(gomega.Equal("10.1.0.0/16"))
+	g.Expect(custom.clientSubnet("2001:db8:1:2:3::1", 0, false)).To(gomega.Equal("2001:db8:1::/48"))
+}
@@ -0,0 +1,103 @@
+package lb
+
+import (
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	"google.golang.org/protobuf/proto"
+	"sync"
+	"time"
+)
+
+// slowStartScale is applied on ratios of all members of an entry while one is ramping up,
+// it lets a recovering member get a fraction of a ratio of 1.
+const slowStartScale = 100
+
+const defaultSlowStartMinPercent = 10
+
+// SlowStart keeps track of members transitions between catalog snapshots of entries and ramps up
+// ratio of members coming back active.
+type SlowStart struct {
+	mu sync.Mutex
+	// activeSince gives by fqdn and member ip when member became active, members active when an entry
+	// is first seen are considered active since ever
+	activeSince map[string]map[string]time.Time
+	now         func() time.Time
+}
+
+func NewSlowStart() *SlowStart {
+	return &SlowStart{
+		activeSince: make(map[string]map[string]time.Time),
+		now:         time.Now,
+	}
+}
+
+// Ramp records members active for entry and gives member lists, in same order, where ratios of members which
+// became active for less than window are lowered, ratio grows linearly from minPercent of ratio to full ratio.
+// Given members are never modified, copies are given when ratios are changed.
+func (s *SlowStart) Ramp(fqdn string, window time.Duration, minPercent uint32, memberLists ...[]*entries.Member) [][]*entries.Member {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	previous, known := s.activeSince[fqdn]
+	current := make(map[string]time.Time)
+	for _, members := range memberLists {
+		for _, member := range members {
+			since, ok := previous[member.GetIp()]
+			if !ok && known {
+				since = now
+			}
+			current[member.GetIp()] = since
+		}
+	}
+	s.activeSince[fqdn] = current
+	if window <= 0 {
+		return memberLists
+	}
+	if minPercent == 0 {
+		minPercent = defaultSlowStartMinPercent
+	}
+	ramping := false
+	for _, since := range current {
+		if now.Sub(since) < window {
+			ramping = true
+			break
+		}
+	}
+	if !ramping {
+		return memberLists
+	}
+	// all lists are scaled as lb algorithms may use ipv4 and ipv6 members together
+	ramped := make([][]*entries.Member, len(memberLists))
+	for i, members := range memberLists {
+		ramped[i] = rampMembers(members, current, now, window, minPercent)
+	}
+	return ramped
+}
+
+func rampMembers(members []*entries.Member, activeSince map[string]time.Time, now time.Time, window time.Duration, minPercent uint32) []*entries.Member {
+	ramped := make([]*entries.Member, len(members))
+	for i, member := range members {
+		ratio := member.GetRatio()
+		if ratio == 0 {
+			ratio = 1
+		}
+		ratio *= slowStartScale
+		elapsed := now.Sub(activeSince[member.GetIp()])
+		if elapsed < window {
+			percent := float64(minPercent) + float64(100-minPercent)*elapsed.Seconds()/window.Seconds()
+			ratio = uint32(float64(ratio) * percent / 100)
+			if ratio == 0 {
+				ratio = 1
+			}
+		}
+		ramped[i] = proto.Clone(member).(*entries.Member)
+		ramped[i].Ratio = ratio
+	}
+	return ramped
+}
+
+// Forget drops members known for entry.
+func (s *SlowStart) Forget(fqdn string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.activeSince, fqdn)
+}
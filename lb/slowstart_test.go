@@ -0,0 +1,152 @@
+package lb
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	"testing"
+	"time"
+)
+
+const slowStartTestWindow = 100 * time.Second
+
+// newTestSlowStart gives a slow start with a clock moved by advancing returned time.
+func newTestSlowStart() (*SlowStart, *time.Time) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewSlowStart()
+	s.now = func() time.Time {
+		return now
+	}
+	return s, &now
+}
+
+func ratiosOf(members []*entries.Member) []uint32 {
+	ratios := make([]uint32, len(members))
+	for i, member := range members {
+		ratios[i] = member.GetRatio()
+	}
+	return ratios
+}
+
+// expectSameMembers checks given members are returned as is.
+func expectSameMembers(g *gomega.WithT, actual, expected []*entries.Member) {
+	g.Expect(actual).To(gomega.HaveLen(len(expected)))
+	for i := range expected {
+		g.Expect(actual[i]).To(gomega.BeIdenticalTo(expected[i]))
+	}
+}
+
+func TestSlowStartRampsMemberComingBack(t *testing.T) {
+	g := gomega.NewWithT(t)
+	s, now := newTestSlowStart()
+	a := &entries.Member{Ip: "10.0.0.1", Ratio: 2}
+	b := &entries.Member{Ip: "10.0.0.2", Ratio: 2}
+
+	// members active when entry is first seen are not ramped
+	all := []*entries.Member{a, b}
+	ramped := s.Ramp("app.example.com.", slowStartTestWindow, 0, all)
+	expectSameMembers(g, ramped[0], all)
+	s.Ramp("app.example.com.", slowStartTestWindow, 0, []*entries.Member{a})
+
+	ramped = s.Ramp("app.example.com.", slowStartTestWindow, 0, []*entries.Member{a, b})
+	g.Expect(ratiosOf(ramped[0])).To(gomega.Equal([]uint32{2 * slowStartScale, 2 * slowStartScale * defaultSlowStartMinPercent / 100}))
+
+	*now = now.Add(slowStartTestWindow / 2)
+	ramped = s.Ramp("app.example.com.", slowStartTestWindow, 0, []*entries.Member{a, b})
+	g.Expect(ratiosOf(ramped[0])).To(gomega.Equal([]uint32{2 * slowStartScale, 2 * slowStartScale * 55 / 100}))
+
+	*now = now.Add(slowStartTestWindow / 2)
+	all = []*entries.Member{a, b}
+	ramped = s.Ramp("app.example.com.", slowStartTestWindow, 0, all)
+	expectSameMembers(g, ramped[0], all)
+
+	// given members are never modified
+	g.Expect(a.GetRatio()).To(gomega.Equal(uint32(2)))
+	g.Expect(b.GetRatio()).To(gomega.Equal(uint32(2)))
+}
+
+func TestSlowStartRamp(t *testing.T) {
+	cases := []struct {
+		name       string
+		window     time.Duration
+		minPercent uint32
+		elapsed    time.Duration
+		ratio      uint32
+		// ratios of stable member and member coming back, nil when lists are given as is
+		ratios []uint32
+	}{
+		{
+			name:    "disabled",
+			window:  0,
+			elapsed: 0,
+			ratio:   1,
+		},
+		{
+			name:       "custom min percent",
+			window:     slowStartTestWindow,
+			minPercent: 50,
+			ratio:      4,
+			ratios:     []uint32{4 * slowStartScale, 4 * slowStartScale / 2},
+		},
+		{
+			name:    "no ratio counts as 1",
+			window:  slowStartTestWindow,
+			elapsed: slowStartTestWindow / 4,
+			ratio:   0,
+			ratios:  []uint32{slowStartScale, slowStartScale * 325 / 1000},
+		},
+		{
+			name:    "window elapsed",
+			window:  slowStartTestWindow,
+			elapsed: slowStartTestWindow,
+			ratio:   1,
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			s, now := newTestSlowStart()
+			stable := &entries.Member{Ip: "10.0.0.1", Ratio: c.ratio}
+			back := &entries.Member{Ip: "10.0.0.2", Ratio: c.ratio}
+			s.Ramp("app.example.com.", c.window, c.minPercent, []*entries.Member{stable})
+			s.Ramp("app.example.com.", c.window, c.minPercent, []*entries.Member{stable, back})
+
+			*now = now.Add(c.elapsed)
+			members := []*entries.Member{stable, back}
+			ramped := s.Ramp("app.example.com.", c.window, c.minPercent, members)
+			if c.ratios == nil {
+				expectSameMembers(g, ramped[0], members)
+				return
+			}
+			g.Expect(ratiosOf(ramped[0])).To(gomega.Equal(c.ratios))
+		})
+	}
+}
+
+func TestSlowStartScalesAllLists(t *testing.T) {
+	g := gomega.NewWithT(t)
+	s, _ := newTestSlowStart()
+	ipv4 := []*entries.Member{{Ip: "10.0.0.1", Ratio: 1}}
+	ipv6 := []*entries.Member{{Ip: "2001:db8::1", Ratio: 1}}
+	s.Ramp("app.example.com.", slowStartTestWindow, 0, ipv4, nil)
+
+	ramped := s.Ramp("app.example.com.", slowStartTestWindow, 0, ipv4, ipv6)
+	g.Expect(ramped).To(gomega.HaveLen(2))
+	// stable ipv4 member is scaled as well to keep its share against ramping ipv6 member
+	g.Expect(ratiosOf(ramped[0])).To(gomega.Equal([]uint32{slowStartScale}))
+	g.Expect(ratiosOf(ramped[1])).To(gomega.Equal([]uint32{slowStartScale * defaultSlowStartMinPercent / 100}))
+}
+
+func TestSlowStartForget(t *testing.T) {
+	g := gomega.NewWithT(t)
+	s, _ := newTestSlowStart()
+	stable := &entries.Member{Ip: "10.0.0.1", Ratio: 1}
+	back := &entries.Member{Ip: "10.0.0.2", Ratio: 1}
+	s.Ramp("app.example.com.", slowStartTestWindow, 0, []*entries.Member{stable})
+	s.Forget("app.example.com.")
+
+	// entry is seen again as a new one, its members are not ramped
+	members := []*entries.Member{stable, back}
+	ramped := s.Ramp("app.example.com.", slowStartTestWindow, 0, members)
+	expectSameMembers(g, ramped[0], members)
+}
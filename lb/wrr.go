@@ -3,15 +3,18 @@ package lb
 import (
 	"context"
 	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
-	"sync/atomic"
+	"sync"
 )
 
+// weightedRef holds state of smooth weighted round-robin (as done by nginx) over members,
+// each selection adds weight of members to their current weight, member with the highest
+// current weight is selected and total of weights is removed from its current weight.
 type weightedRef struct {
-	gcd           int
-	maxWeight     uint32
-	currentWeight *atomic.Int32
-	index         *atomic.Int32
-	members       []*entries.Member
+	mu             sync.Mutex
+	members        []*entries.Member
+	weights        []int64
+	currentWeights []int64
+	totalWeight    int64
 }
 
 type WeightedRoundRobin struct {
@@ -32,6 +35,7 @@ func NewWeightedRoundRobin(entry *entries.Entry) *WeightedRoundRobin {
 	}
 	return wrr
 }
+
 func (wrr *WeightedRoundRobin) nextMember(wr *weightedRef) (*entries.Member, error) {
 	if len(wr.members) == 0 {
 		return nil, nil
@@ -39,22 +43,17 @@ func (wrr *WeightedRoundRobin) nextMember(wr *weightedRef) (*entries.Member, err
 	if len(wr.members) == 1 {
 		return wr.members[0], nil
 	}
-	for {
-		newIndex := (wr.index.Load() + 1) % int32(len(wr.members))
-		wr.index.Store(newIndex)
-		if newIndex == 0 {
-			wr.currentWeight.Store(wr.currentWeight.Load() - int32(wr.gcd))
-			if wr.currentWeight.Load() <= 0 {
-				wr.currentWeight.Store(int32(wr.maxWeight))
-				if wr.currentWeight.Load() == 0 {
-					return nil, nil
-				}
-			}
-		}
-		if wr.members[newIndex].GetRatio() >= uint32(wr.currentWeight.Load()) {
-			return wr.members[newIndex], nil
+	wr.mu.Lock()
+	defer wr.mu.Unlock()
+	best := 0
+	for i := range wr.members {
+		wr.currentWeights[i] += wr.weights[i]
+		if wr.currentWeights[i] > wr.currentWeights[best] {
+			best = i
 		}
 	}
+	wr.currentWeights[best] -= wr.totalWeight
+	return wr.members[best], nil
 }
 
 func (wrr *WeightedRoundRobin) Next(_ context.Context, memberType MemberType) (*entries.Member, error) {
@@ -71,12 +70,9 @@ func (wrr *WeightedRoundRobin) Next(_ context.Context, memberType MemberType) (*
 }
 
 func (wrr *WeightedRoundRobin) Reset() error {
-	wrr.wrAll.index.Store(-1)
-	wrr.wrAll.currentWeight.Store(0)
-	wrr.wrIpv4.index.Store(-1)
-	wrr.wrIpv4.currentWeight.Store(0)
-	wrr.wrIpv6.index.Store(-1)
-	wrr.wrIpv6.currentWeight.Store(0)
+	wrr.wrAll.reset()
+	wrr.wrIpv4.reset()
+	wrr.wrIpv6.reset()
 	return nil
 }
 
@@ -84,40 +80,27 @@ func (wrr *WeightedRoundRobin) Name() string {
 	return "weighted_round_robin"
 }
 
+func (wr *weightedRef) reset() {
+	wr.mu.Lock()
+	defer wr.mu.Unlock()
+	for i := range wr.currentWeights {
+		wr.currentWeights[i] = 0
+	}
+}
+
 func membersToWeightedRef(members []*entries.Member) *weightedRef {
 	wr := &weightedRef{
-		gcd:           0,
-		maxWeight:     0,
-		currentWeight: new(atomic.Int32),
-		index:         new(atomic.Int32),
-		members:       members,
+		members:        members,
+		weights:        make([]int64, len(members)),
+		currentWeights: make([]int64, len(members)),
 	}
-	wr.index.Store(-1)
-	wr.currentWeight.Store(0)
-	for _, member := range members {
-		weight := member.GetRatio()
+	for i, member := range members {
+		weight := int64(member.GetRatio())
 		if weight == 0 {
 			weight = 1
 		}
-		if wr.gcd == 0 {
-			wr.gcd = int(weight)
-			wr.maxWeight = weight
-		} else {
-			wr.gcd = gcd(uint32(wr.gcd), weight)
-		}
+		wr.weights[i] = weight
+		wr.totalWeight += weight
 	}
 	return wr
 }
-
-func gcd(x, y uint32) int {
-	var t int
-	for {
-		t = int(x) % int(y)
-		if t > 0 {
-			x = y
-			y = uint32(t)
-		} else {
-			return int(y)
-		}
-	}
-}
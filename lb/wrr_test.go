@@ -0,0 +1,91 @@
+package lb
+
+import (
+	"context"
+	"github.com/onsi/gomega"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	"sync"
+	"testing"
+)
+
+func TestWeightedRoundRobinConcurrentDistribution(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	entry := &entries.Entry{
+		Fqdn: "wrr.example.com.",
+		MembersIpv4: []*entries.Member{
+			{Ip: "10.0.0.1", Ratio: 1},
+			{Ip: "10.0.0.2", Ratio: 2},
+			{Ip: "10.0.0.3", Ratio: 5},
+			// no ratio counts as 1
+			{Ip: "10.0.0.4"},
+		},
+	}
+	wrr := NewWeightedRoundRobin(entry)
+
+	const goroutines = 16
+	const picksPerGoroutine = 1000
+	var mu sync.Mutex
+	counts := make(map[string]int)
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			local := make(map[string]int)
+			for j := 0; j < picksPerGoroutine; j++ {
+				member, err := wrr.Next(context.Background(), Ipv4)
+				if err != nil || member == nil {
+					t.Errorf("unexpected pick %v: %v", member, err)
+					return
+				}
+				local[member.GetIp()]++
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for ip, count := range local {
+				counts[ip] += count
+			}
+		}()
+	}
+	wg.Wait()
+
+	total := goroutines * picksPerGoroutine
+	totalWeight := 1 + 2 + 5 + 1
+	weights := map[string]int{
+		"10.0.0.1": 1,
+		"10.0.0.2": 2,
+		"10.0.0.3": 5,
+		"10.0.0.4": 1,
+	}
+	sum := 0
+	for ip, weight := range weights {
+		share := float64(counts[ip]) / float64(total)
+		g.Expect(share).To(gomega.BeNumerically("~", float64(weight)/float64(totalWeight), 0.01), "share of %s", ip)
+		sum += counts[ip]
+	}
+	g.Expect(sum).To(gomega.Equal(total))
+}
+
+func TestWeightedRoundRobinSmoothSequence(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	wrr := NewWeightedRoundRobin(&entries.Entry{
+		Fqdn: "wrr.example.com.",
+		MembersIpv4: []*entries.Member{
+			{Ip: "10.0.0.1", Ratio: 5},
+			{Ip: "10.0.0.2", Ratio: 1},
+			{Ip: "10.0.0.3", Ratio: 1},
+		},
+	})
+	ips := make([]string, 0, 7)
+	for i := 0; i < 7; i++ {
+		member, err := wrr.Next(context.Background(), Ipv4)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		ips = append(ips, member.GetIp())
+	}
+	// heavier member is interleaved with others instead of being picked in a burst
+	g.Expect(ips).To(gomega.Equal([]string{
+		"10.0.0.1", "10.0.0.1", "10.0.0.2", "10.0.0.1", "10.0.0.3", "10.0.0.1", "10.0.0.1",
+	}))
+}
@@ -41,6 +41,9 @@ func (l *VersionCmd) Run() error {
 
 var cli struct {
 	Serve   ServeCmd   `cmd:"" help:"Run server."`
+	Export  ExportCmd  `cmd:"" help:"Export entries to a yaml or json file."`
+	Import  ImportCmd  `cmd:"" help:"Import entries from a yaml or json file."`
+	Apply   ApplyCmd   `cmd:"" help:"Apply desired entries from files, only changes are written."`
 	Version VersionCmd `cmd:"" help:"Show version."`
 }
 
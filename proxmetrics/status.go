@@ -14,6 +14,7 @@ import (
 
 type StatusCollector struct {
 	desc        *prometheus.Desc
+	weightDesc  *prometheus.Desc
 	gslocConsul *disco.GslocConsul
 }
 
@@ -80,11 +81,18 @@ func NewStatusCollector(gslocConsul *disco.GslocConsul) *StatusCollector {
 			[]string{"fqdn", "member_ip", "dc", "type"},
 			nil,
 		),
+		weightDesc: prometheus.NewDesc(
+			"gsloc_member_effective_weight",
+			"Weight computed for member of entry using adaptive weights, it replaces member ratio.",
+			[]string{"fqdn", "member_ip", "dc"},
+			nil,
+		),
 	}
 }
 
 func (s *StatusCollector) Describe(descs chan<- *prometheus.Desc) {
 	descs <- s.desc
+	descs <- s.weightDesc
 }
 
 func (s *StatusCollector) Collect(metrics chan<- prometheus.Metric) {
@@ -101,6 +109,24 @@ func (s *StatusCollector) Collect(metrics chan<- prometheus.Metric) {
 			metrics <- s.memberStatusToProm(entryStatus.Fqdn, entry, false)
 		}
 	}
+
+	weights, err := s.gslocConsul.ListEffectiveWeights("")
+	if err != nil {
+		log.WithError(err).Error("Failed to list effective weights")
+		return
+	}
+	for _, effectiveWeights := range weights {
+		for _, memberWeight := range effectiveWeights.GetMembers() {
+			metrics <- prometheus.MustNewConstMetric(
+				s.weightDesc,
+				prometheus.GaugeValue,
+				float64(memberWeight.GetWeight()),
+				effectiveWeights.GetFqdn(),
+				memberWeight.GetIp(),
+				effectiveWeights.GetDc(),
+			)
+		}
+	}
 }
 
 func (s *StatusCollector) memberStatusToProm(fqdn string, ms *gslbsvc.MemberStatus, isIpv4 bool) prometheus.Metric {
@@ -0,0 +1,264 @@
+package proxmetrics
+
+import (
+	"context"
+	consul "github.com/hashicorp/consul/api"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/rets"
+	dto "github.com/prometheus/client_model/go"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"math"
+	"net"
+	"sort"
+	"time"
+)
+
+const (
+	defaultWeightIpLabel   = "instance"
+	defaultMinWeight       = 1
+	defaultMaxWeight       = 100
+	defaultWeightSmoothing = 0.3
+)
+
+func weightsKey(fqdn, dcName string) string {
+	return config.ConsulKVWeightsPrefix + fqdn + "/" + dcName
+}
+
+// WeightComputer computes weights of members of this dc for entries using adaptive weights from metrics
+// scraped on proxy metrics targets, weights are written in kv and used as member ratios by all nodes.
+type WeightComputer struct {
+	entry        *log.Entry
+	consulClient *consul.Client
+	dcName       string
+	interval     time.Duration
+	fetcher      *Fetcher
+	retriever    *rets.Retriever
+	// smoothed metric value by fqdn and member ip
+	smoothed map[string]map[string]float64
+	// fqdns for which weights have been written by this node
+	reported map[string]struct{}
+}
+
+func NewWeightComputer(consulClient *consul.Client, dcName string, interval time.Duration, fetcher *Fetcher, retriever *rets.Retriever) *WeightComputer {
+	return &WeightComputer{
+		entry:        log.WithField("component", "weight_computer"),
+		consulClient: consulClient,
+		dcName:       dcName,
+		interval:     interval,
+		fetcher:      fetcher,
+		retriever:    retriever,
+		smoothed:     make(map[string]map[string]float64),
+		reported:     make(map[string]struct{}),
+	}
+}
+
+func (c *WeightComputer) Run(ctx context.Context) {
+	c.entry.Info("starting weight computer ...")
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			c.entry.Info("weight computer stopped")
+			return
+		case <-ticker.C:
+			if !c.retriever.KVSynced() {
+				continue
+			}
+			c.computeAll()
+		}
+	}
+}
+
+func (c *WeightComputer) computeAll() {
+	var samples map[string][]*dto.Metric
+	reporting := make(map[string]struct{})
+	smoothed := make(map[string]map[string]float64)
+	for _, signedEntry := range c.retriever.ListEntries("") {
+		fqdn := signedEntry.GetEntry().GetFqdn()
+		policy := c.retriever.GetPolicy(fqdn).GetAdaptiveWeights()
+		if policy == nil {
+			continue
+		}
+		// targets are only scraped when an entry needs them
+		if samples == nil {
+			samples = c.scrapeTargets()
+		}
+		values := c.smooth(fqdn, c.memberValues(signedEntry.GetEntry(), policy, samples[policy.GetMetric()]), policy)
+		if len(values) == 0 {
+			continue
+		}
+		smoothed[fqdn] = values
+		reporting[fqdn] = struct{}{}
+		err := c.writeWeights(fqdn, makeEffectiveWeights(fqdn, c.dcName, values, policy, 3*c.interval))
+		if err != nil {
+			c.entry.WithError(err).Errorf("failed to write effective weights of %s", fqdn)
+		}
+	}
+	for fqdn := range c.reported {
+		if _, ok := reporting[fqdn]; ok {
+			continue
+		}
+		_, err := c.consulClient.KV().Delete(weightsKey(fqdn, c.dcName), nil)
+		if err != nil {
+			c.entry.WithError(err).Errorf("failed to delete effective weights of %s", fqdn)
+			reporting[fqdn] = struct{}{}
+		}
+	}
+	c.reported = reporting
+	c.smoothed = smoothed
+}
+
+// scrapeTargets gives samples found on all proxy metrics targets by metric name.
+func (c *WeightComputer) scrapeTargets() map[string][]*dto.Metric {
+	samples := make(map[string][]*dto.Metric)
+	for _, target := range c.fetcher.targets {
+		mfs, err := c.fetcher.Metric(target)
+		if err != nil {
+			c.entry.WithError(err).Warnf("cannot get metrics of target %s", target.Name)
+			continue
+		}
+		for _, mf := range mfs {
+			samples[mf.GetName()] = append(samples[mf.GetName()], mf.GetMetric()...)
+		}
+	}
+	return samples
+}
+
+// memberValues gives metric value of enabled members of this dc, value is averaged when several samples match a member.
+func (c *WeightComputer) memberValues(entry *entries.Entry, policy *opssvc.AdaptiveWeightPolicy, samples []*dto.Metric) map[string]float64 {
+	ipLabel := policy.GetIpLabel()
+	if ipLabel == "" {
+		ipLabel = defaultWeightIpLabel
+	}
+	sums := make(map[string]float64)
+	counts := make(map[string]int)
+	for _, sample := range samples {
+		value, ok := sampleValue(sample)
+		if !ok || math.IsNaN(value) || math.IsInf(value, 0) {
+			continue
+		}
+		for _, label := range sample.GetLabel() {
+			if label.GetName() != ipLabel {
+				continue
+			}
+			ip, _, err := net.SplitHostPort(label.GetValue())
+			if err != nil {
+				ip = label.GetValue()
+			}
+			sums[ip] += value
+			counts[ip]++
+		}
+	}
+	members := append([]*entries.Member{}, entry.GetMembersIpv4()...)
+	members = append(members, entry.GetMembersIpv6()...)
+	values := make(map[string]float64)
+	for _, member := range members {
+		if member.GetDc() != c.dcName || member.GetDisabled() {
+			continue
+		}
+		if counts[member.GetIp()] == 0 {
+			continue
+		}
+		values[member.GetIp()] = sums[member.GetIp()] / float64(counts[member.GetIp()])
+	}
+	return values
+}
+
+// smooth applies exponential moving average on values with values of previous computation.
+func (c *WeightComputer) smooth(fqdn string, values map[string]float64, policy *opssvc.AdaptiveWeightPolicy) map[string]float64 {
+	alpha := policy.GetSmoothing()
+	if alpha == 0 {
+		alpha = defaultWeightSmoothing
+	}
+	previous := c.smoothed[fqdn]
+	for ip, value := range values {
+		if prev, ok := previous[ip]; ok {
+			values[ip] = alpha*value + (1-alpha)*prev
+		}
+	}
+	return values
+}
+
+func (c *WeightComputer) writeWeights(fqdn string, weights *opssvc.EffectiveWeights) error {
+	val, err := protojson.Marshal(weights)
+	if err != nil {
+		return err
+	}
+	_, err = c.consulClient.KV().Put(&consul.KVPair{
+		Key:   weightsKey(fqdn, c.dcName),
+		Value: val,
+	}, nil)
+	return err
+}
+
+func sampleValue(sample *dto.Metric) (float64, bool) {
+	switch {
+	case sample.GetGauge() != nil:
+		return sample.GetGauge().GetValue(), true
+	case sample.GetCounter() != nil:
+		return sample.GetCounter().GetValue(), true
+	case sample.GetUntyped() != nil:
+		return sample.GetUntyped().GetValue(), true
+	}
+	return 0, false
+}
+
+// makeEffectiveWeights gives weights proportional to values, or inversely proportional when values are loads,
+// best member gets max weight and weights are bounded by min weight.
+func makeEffectiveWeights(fqdn, dcName string, values map[string]float64, policy *opssvc.AdaptiveWeightPolicy, ttl time.Duration) *opssvc.EffectiveWeights {
+	minWeight := float64(policy.GetMinWeight())
+	if minWeight == 0 {
+		minWeight = defaultMinWeight
+	}
+	maxWeight := float64(policy.GetMaxWeight())
+	if maxWeight == 0 {
+		maxWeight = defaultMaxWeight
+	}
+	best := math.NaN()
+	for _, value := range values {
+		if policy.GetHigherIsBetter() && (math.IsNaN(best) || value > best) {
+			best = value
+		}
+		if !policy.GetHigherIsBetter() && value > 0 && (math.IsNaN(best) || value < best) {
+			best = value
+		}
+	}
+
+	now := time.Now()
+	weights := &opssvc.EffectiveWeights{
+		Fqdn:       fqdn,
+		Dc:         dcName,
+		ComputedAt: timestamppb.New(now),
+		ExpiresAt:  timestamppb.New(now.Add(ttl)),
+		Members:    make([]*opssvc.MemberWeight, 0, len(values)),
+	}
+	for ip, value := range values {
+		var weight float64
+		switch {
+		case policy.GetHigherIsBetter() && best > 0:
+			weight = maxWeight * value / best
+		case policy.GetHigherIsBetter():
+			weight = minWeight
+		case value <= 0 || math.IsNaN(best):
+			// no load
+			weight = maxWeight
+		default:
+			weight = maxWeight * best / value
+		}
+		weight = math.Max(minWeight, math.Min(maxWeight, math.Round(weight)))
+		weights.Members = append(weights.Members, &opssvc.MemberWeight{
+			Ip:     ip,
+			Weight: uint32(weight),
+			Value:  value,
+		})
+	}
+	sort.Slice(weights.Members, func(i, j int) bool {
+		return weights.Members[i].GetIp() < weights.Members[j].GetIp()
+	})
+	return weights
+}
@@ -27,6 +27,7 @@ type entryRef struct {
 	lbPreferred lb.Loadbalancer
 	lbAlternate lb.Loadbalancer
 	lbFallback  lb.Loadbalancer
+	groups      *priorityGroups
 }
 
 type GSLBHandler struct {
@@ -34,26 +35,39 @@ type GSLBHandler struct {
 	lbFactory      *lb.LBFactory
 	trustEdns      bool
 	allowedInspect []*config.CIDR
+	policies       EntryPolicyRetriever
 }
 
-func NewGSLBHandler(lbFactory *lb.LBFactory, trustEdns bool, allowedInspect []*config.CIDR) *GSLBHandler {
+func NewGSLBHandler(lbFactory *lb.LBFactory, trustEdns bool, allowedInspect []*config.CIDR, policies EntryPolicyRetriever) *GSLBHandler {
 	return &GSLBHandler{
 		entries:        &sync.Map{},
 		lbFactory:      lbFactory,
 		trustEdns:      trustEdns,
 		allowedInspect: allowedInspect,
+		policies:       policies,
 	}
 }
 
 func (h *GSLBHandler) SetCatalogEntry(entry *entries.Entry) {
 	h.entries.Store(entry.Fqdn, entryRef{
 		entry:       entry,
-		lbPreferred: h.lbFactory.MakeLb(entry, entry.GetLbAlgoPreferred()),
+		lbPreferred: h.makePreferredLb(entry),
 		lbAlternate: h.lbFactory.MakeLb(entry, entry.GetLbAlgoAlternate()),
 		lbFallback:  h.lbFactory.MakeLb(entry, entry.GetLbAlgoFallback()),
+		groups:      h.makePriorityGroups(entry),
 	})
 }
 
+// makePreferredLb gives preferred lb of entry, client affinity policy replaces preferred lb algorithm of entry.
+func (h *GSLBHandler) makePreferredLb(entry *entries.Entry) lb.Loadbalancer {
+	if h.policies != nil {
+		if affinity := h.policies.GetPolicy(entry.GetFqdn()).GetClientAffinity(); affinity != nil {
+			return lb.NewConsistentHash(entry, affinity.GetIpv4PrefixLength(), affinity.GetIpv6PrefixLength())
+		}
+	}
+	return h.lbFactory.MakeLb(entry, entry.GetLbAlgoPreferred())
+}
+
 func (h *GSLBHandler) RemoveCatalogEntry(entry *entries.Entry) {
 	h.entries.Delete(entry.GetFqdn())
 }
@@ -63,16 +77,18 @@ func (h *GSLBHandler) ServeDNS(w dns.ResponseWriter, msg *dns.Msg) {
 	if err != nil {
 		log.Errorf("error parsing remote addr: %s", err)
 	}
+	ctx := context.Background()
 	o := msg.IsEdns0()
 	if o != nil && h.trustEdns {
 		for _, s := range o.Option {
 			if e, ok := s.(*dns.EDNS0_SUBNET); ok {
 				remoteAddr = e.Address.String()
+				ctx = contexes.SetClientSubnetMask(ctx, e.SourceNetmask)
 				break
 			}
 		}
 	}
-	ctx := contexes.SetRemoteAddr(context.Background(), remoteAddr)
+	ctx = contexes.SetRemoteAddr(ctx, remoteAddr)
 	ctx = contexes.SetDNSMsg(ctx, msg)
 	m := new(dns.Msg)
 	m.SetReply(msg)
@@ -267,6 +283,11 @@ func (h *GSLBHandler) findMembers(ctx context.Context, er entryRef, memberType l
 }
 
 func (h *GSLBHandler) findMember(ctx context.Context, er entryRef, memberType lb.MemberType, prevErr error) (*entries.Member, error) {
+	if er.groups != nil {
+		group := er.groups.selectGroup(memberType)
+		stats.AddPriorityGroup(er.entry.GetFqdn(), group.priority)
+		er = group.ref
+	}
 	lbler := er.lbPreferred
 	if prevErr != nil {
 		lbler = er.lbAlternate
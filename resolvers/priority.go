@@ -0,0 +1,156 @@
+package resolvers
+
+import (
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/lb"
+	"sort"
+	"strings"
+)
+
+// EntryPolicyRetriever gives policies and kv entries, kv entries give all members of an entry
+// when catalog entries only give active ones.
+type EntryPolicyRetriever interface {
+	GetPolicy(fqdn string) *opssvc.EntryPolicy
+	GetEntry(fqdn string) (*entries.SignedEntry, bool)
+}
+
+type priorityGroup struct {
+	priority  uint32
+	ref       entryRef
+	totalIpv4 int
+	totalIpv6 int
+}
+
+func (g *priorityGroup) counts(memberType lb.MemberType) (active int, total int) {
+	switch memberType {
+	case lb.All:
+		return len(g.ref.entry.GetMembersIpv4()) + len(g.ref.entry.GetMembersIpv6()), g.totalIpv4 + g.totalIpv6
+	case lb.Ipv6:
+		return len(g.ref.entry.GetMembersIpv6()), g.totalIpv6
+	default:
+		return len(g.ref.entry.GetMembersIpv4()), g.totalIpv4
+	}
+}
+
+type priorityGroups struct {
+	groups           []*priorityGroup
+	minActiveMembers int
+	minActivePercent int
+}
+
+// selectGroup gives highest priority group with enough active members,
+// when none has enough, highest priority group with at least one active member is given.
+func (pg *priorityGroups) selectGroup(memberType lb.MemberType) *priorityGroup {
+	var firstActive *priorityGroup
+	for _, group := range pg.groups {
+		active, total := group.counts(memberType)
+		if active == 0 {
+			continue
+		}
+		if firstActive == nil {
+			firstActive = group
+		}
+		if active >= pg.minActiveMembers && active*100 >= pg.minActivePercent*total {
+			return group
+		}
+	}
+	if firstActive == nil {
+		return pg.groups[0]
+	}
+	return firstActive
+}
+
+func (h *GSLBHandler) makePriorityGroups(entry *entries.Entry) *priorityGroups {
+	if h.policies == nil {
+		return nil
+	}
+	policy := h.policies.GetPolicy(entry.GetFqdn()).GetPriorityGroups()
+	if len(policy.GetGroups()) == 0 {
+		return nil
+	}
+	byIp := make(map[string]uint32)
+	byDc := make(map[string]uint32)
+	for _, group := range policy.GetGroups() {
+		for _, dc := range group.GetDcs() {
+			byDc[dc] = group.GetPriority()
+		}
+		for _, ip := range group.GetIps() {
+			byIp[ip] = group.GetPriority()
+		}
+	}
+	priorityOf := func(member *entries.Member) uint32 {
+		if priority, ok := byIp[member.GetIp()]; ok {
+			return priority
+		}
+		return byDc[member.GetDc()]
+	}
+
+	groupsByPriority := make(map[uint32]*priorityGroup)
+	groupOf := func(member *entries.Member) *priorityGroup {
+		priority := priorityOf(member)
+		group, ok := groupsByPriority[priority]
+		if !ok {
+			group = &priorityGroup{
+				priority: priority,
+				ref: entryRef{
+					entry: &entries.Entry{
+						Fqdn:              entry.GetFqdn(),
+						LbAlgoPreferred:   entry.GetLbAlgoPreferred(),
+						LbAlgoAlternate:   entry.GetLbAlgoAlternate(),
+						LbAlgoFallback:    entry.GetLbAlgoFallback(),
+						MaxAnswerReturned: entry.GetMaxAnswerReturned(),
+						Ttl:               entry.GetTtl(),
+					},
+				},
+			}
+			groupsByPriority[priority] = group
+		}
+		return group
+	}
+
+	for _, member := range entry.GetMembersIpv4() {
+		group := groupOf(member)
+		group.ref.entry.MembersIpv4 = append(group.ref.entry.MembersIpv4, member)
+	}
+	for _, member := range entry.GetMembersIpv6() {
+		group := groupOf(member)
+		group.ref.entry.MembersIpv6 = append(group.ref.entry.MembersIpv6, member)
+	}
+
+	// members known in kv entry give size of groups, active members are the ones in catalog entry
+	allMembers := append(entry.GetMembersIpv4(), entry.GetMembersIpv6()...)
+	if signedEntry, ok := h.policies.GetEntry(entry.GetFqdn()); ok {
+		allMembers = append(signedEntry.GetEntry().GetMembersIpv4(), signedEntry.GetEntry().GetMembersIpv6()...)
+	}
+	for _, member := range allMembers {
+		group := groupOf(member)
+		if strings.Contains(member.GetIp(), ":") {
+			group.totalIpv6++
+			continue
+		}
+		group.totalIpv4++
+	}
+
+	if len(groupsByPriority) == 0 {
+		return nil
+	}
+	pg := &priorityGroups{
+		groups:           make([]*priorityGroup, 0, len(groupsByPriority)),
+		minActiveMembers: int(policy.GetMinActiveMembers()),
+		minActivePercent: int(policy.GetMinActivePercent()),
+	}
+	if pg.minActiveMembers == 0 {
+		pg.minActiveMembers = 1
+	}
+	for _, group := range groupsByPriority {
+		group.ref.lbPreferred = h.makePreferredLb(group.ref.entry)
+		group.ref.lbAlternate = h.lbFactory.MakeLb(group.ref.entry, entry.GetLbAlgoAlternate())
+		group.ref.lbFallback = h.lbFactory.MakeLb(group.ref.entry, entry.GetLbAlgoFallback())
+		pg.groups = append(pg.groups, group)
+	}
+	sort.Slice(pg.groups, func(i, j int) bool {
+		return pg.groups[i].priority > pg.groups[j].priority
+	})
+	return pg
+}
@@ -0,0 +1,210 @@
+package resolvers
+
+import (
+	"github.com/onsi/gomega"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/lb"
+	"testing"
+)
+
+type fakePolicies struct {
+	policy *opssvc.EntryPolicy
+	entry  *entries.SignedEntry
+}
+
+func (f *fakePolicies) GetPolicy(fqdn string) *opssvc.EntryPolicy {
+	return f.policy
+}
+
+func (f *fakePolicies) GetEntry(fqdn string) (*entries.SignedEntry, bool) {
+	return f.entry, f.entry != nil
+}
+
+// priorityTestMembers gives 3 members in dc1, 2 in dc2 and 1 in dc3 with ipv4 and ipv6 addresses.
+func priorityTestMembers() ([]*entries.Member, []*entries.Member) {
+	ipv4 := []*entries.Member{
+		{Ip: "10.0.1.1", Dc: "dc1"},
+		{Ip: "10.0.1.2", Dc: "dc1"},
+		{Ip: "10.0.1.3", Dc: "dc1"},
+		{Ip: "10.0.2.1", Dc: "dc2"},
+		{Ip: "10.0.2.2", Dc: "dc2"},
+		{Ip: "10.0.3.1", Dc: "dc3"},
+	}
+	ipv6 := []*entries.Member{
+		{Ip: "2001:db8:1::1", Dc: "dc1"},
+		{Ip: "2001:db8:2::1", Dc: "dc2"},
+	}
+	return ipv4, ipv6
+}
+
+func ipsOf(members []*entries.Member) []string {
+	ips := make([]string, len(members))
+	for i, member := range members {
+		ips[i] = member.GetIp()
+	}
+	return ips
+}
+
+func TestSelectGroup(t *testing.T) {
+	cases := []struct {
+		name             string
+		minActiveMembers uint32
+		minActivePercent uint32
+		ips              []string
+		// ips of members in catalog entry, all members are active if empty
+		active     []string
+		memberType lb.MemberType
+		priority   uint32
+		selected   []string
+	}{
+		{
+			name:       "highest priority group",
+			memberType: lb.Ipv4,
+			priority:   10,
+			selected:   []string{"10.0.1.1", "10.0.1.2", "10.0.1.3"},
+		},
+		{
+			name:       "group without active member skipped",
+			active:     []string{"10.0.2.1", "10.0.3.1"},
+			memberType: lb.Ipv4,
+			priority:   5,
+			selected:   []string{"10.0.2.1"},
+		},
+		{
+			name:             "group below min active members skipped",
+			minActiveMembers: 2,
+			active:           []string{"10.0.1.1", "10.0.2.1", "10.0.2.2"},
+			memberType:       lb.Ipv4,
+			priority:         5,
+			selected:         []string{"10.0.2.1", "10.0.2.2"},
+		},
+		{
+			name:             "group reaching min active percent used",
+			minActivePercent: 60,
+			active:           []string{"10.0.1.1", "10.0.1.2", "10.0.2.1"},
+			memberType:       lb.Ipv4,
+			priority:         10,
+			selected:         []string{"10.0.1.1", "10.0.1.2"},
+		},
+		{
+			name:             "group below min active percent skipped",
+			minActivePercent: 50,
+			active:           []string{"10.0.1.1", "10.0.2.1"},
+			memberType:       lb.Ipv4,
+			priority:         5,
+			selected:         []string{"10.0.2.1"},
+		},
+		{
+			name:             "highest group with an active member when none has enough",
+			minActiveMembers: 3,
+			active:           []string{"10.0.1.1", "10.0.2.1", "10.0.3.1"},
+			memberType:       lb.Ipv4,
+			priority:         10,
+			selected:         []string{"10.0.1.1"},
+		},
+		{
+			name:       "members without group have priority 0",
+			active:     []string{"10.0.3.1"},
+			memberType: lb.Ipv4,
+			priority:   0,
+			selected:   []string{"10.0.3.1"},
+		},
+		{
+			name:       "ips take precedence on dcs",
+			ips:        []string{"10.0.3.1"},
+			active:     []string{"10.0.2.1", "10.0.3.1"},
+			memberType: lb.Ipv4,
+			priority:   10,
+			selected:   []string{"10.0.3.1"},
+		},
+		{
+			name:       "ipv6 members counted on their own",
+			active:     []string{"10.0.1.1", "2001:db8:2::1"},
+			memberType: lb.Ipv6,
+			priority:   5,
+			selected:   []string{"2001:db8:2::1"},
+		},
+		{
+			name:       "all members counted for any type",
+			active:     []string{"10.0.2.1", "2001:db8:1::1"},
+			memberType: lb.All,
+			priority:   10,
+			selected:   []string{"2001:db8:1::1"},
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			ipv4, ipv6 := priorityTestMembers()
+			kvEntry := &entries.Entry{
+				Fqdn:        "app.example.com.",
+				MembersIpv4: ipv4,
+				MembersIpv6: ipv6,
+			}
+			catalogEntry := &entries.Entry{
+				Fqdn:        "app.example.com.",
+				MembersIpv4: ipv4,
+				MembersIpv6: ipv6,
+			}
+			if len(c.active) > 0 {
+				active := make(map[string]struct{})
+				for _, ip := range c.active {
+					active[ip] = struct{}{}
+				}
+				isActive := func(members []*entries.Member) []*entries.Member {
+					kept := make([]*entries.Member, 0)
+					for _, member := range members {
+						if _, ok := active[member.GetIp()]; ok {
+							kept = append(kept, member)
+						}
+					}
+					return kept
+				}
+				catalogEntry.MembersIpv4 = isActive(ipv4)
+				catalogEntry.MembersIpv6 = isActive(ipv6)
+			}
+
+			h := NewGSLBHandler(lb.NewLBFactory(nil), false, nil, &fakePolicies{
+				policy: &opssvc.EntryPolicy{
+					PriorityGroups: &opssvc.PriorityGroupPolicy{
+						Groups: []*opssvc.PriorityGroupPolicy_Group{
+							{Priority: 10, Dcs: []string{"dc1"}, Ips: c.ips},
+							{Priority: 5, Dcs: []string{"dc2"}},
+						},
+						MinActiveMembers: c.minActiveMembers,
+						MinActivePercent: c.minActivePercent,
+					},
+				},
+				entry: &entries.SignedEntry{Entry: kvEntry},
+			})
+			pg := h.makePriorityGroups(catalogEntry)
+			g.Expect(pg).ToNot(gomega.BeNil())
+
+			group := pg.selectGroup(c.memberType)
+			g.Expect(group.priority).To(gomega.Equal(c.priority))
+			var selected []string
+			switch c.memberType {
+			case lb.Ipv4:
+				selected = ipsOf(group.ref.entry.GetMembersIpv4())
+			case lb.Ipv6:
+				selected = ipsOf(group.ref.entry.GetMembersIpv6())
+			default:
+				selected = append(ipsOf(group.ref.entry.GetMembersIpv4()), ipsOf(group.ref.entry.GetMembersIpv6())...)
+			}
+			g.Expect(selected).To(gomega.ConsistOf(c.selected))
+		})
+	}
+}
+
+func TestMakePriorityGroupsWithoutPolicy(t *testing.T) {
+	g := gomega.NewWithT(t)
+	h := NewGSLBHandler(lb.NewLBFactory(nil), false, nil, &fakePolicies{})
+	ipv4, _ := priorityTestMembers()
+
+	g.Expect(h.makePriorityGroups(&entries.Entry{
+		Fqdn:        "app.example.com.",
+		MembersIpv4: ipv4,
+	})).To(gomega.BeNil())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/orange-cloudfoundry/gsloc/contexes"
 	"github.com/prometheus/client_golang/prometheus"
+	"strconv"
 )
 
 var stats = metrics{
@@ -37,6 +38,16 @@ var stats = metrics{
 		"lb_type",
 	}),
 
+	priorityGroup: prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gsloc",
+		Subsystem: "dns_handler",
+		Name:      "priority_group",
+		Help:      "Number of member selections made in priority group of given priority",
+	}, []string{
+		"fqdn",
+		"priority",
+	}),
+
 	querySuccess: prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "gsloc",
 		Subsystem: "dns_handler",
@@ -61,17 +72,19 @@ var stats = metrics{
 }
 
 type metrics struct {
-	preferred    *prometheus.CounterVec
-	alternate    *prometheus.CounterVec
-	fallback     *prometheus.CounterVec
-	querySuccess *prometheus.CounterVec
-	queryFailed  *prometheus.CounterVec
+	preferred     *prometheus.CounterVec
+	alternate     *prometheus.CounterVec
+	fallback      *prometheus.CounterVec
+	priorityGroup *prometheus.CounterVec
+	querySuccess  *prometheus.CounterVec
+	queryFailed   *prometheus.CounterVec
 }
 
 func init() {
 	prometheus.MustRegister(stats.preferred)
 	prometheus.MustRegister(stats.alternate)
 	prometheus.MustRegister(stats.fallback)
+	prometheus.MustRegister(stats.priorityGroup)
 	prometheus.MustRegister(stats.querySuccess)
 	prometheus.MustRegister(stats.queryFailed)
 }
@@ -88,6 +101,10 @@ func (m *metrics) AddFallback(fqdn, lbType string) {
 	m.fallback.WithLabelValues(fqdn, lbType).Add(1)
 }
 
+func (m *metrics) AddPriorityGroup(fqdn string, priority uint32) {
+	m.priorityGroup.WithLabelValues(fqdn, strconv.Itoa(int(priority))).Add(1)
+}
+
 func (m *metrics) AddQuerySuccess(ctx context.Context, fqdn, queryType string) {
 	m.querySuccess.WithLabelValues(fqdn, queryType, contexes.GetRemoteAddr(ctx)).Add(1)
 }
@@ -7,14 +7,18 @@ import (
 	"github.com/miekg/dns"
 	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
 	"github.com/orange-cloudfoundry/gsloc-go-sdk/helpers"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
 	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/lb"
 	"github.com/orange-cloudfoundry/gsloc/observe"
+	"github.com/orange-cloudfoundry/gsloc/signs"
 	log "github.com/sirupsen/logrus"
 	"github.com/sourcegraph/conc/pool"
 	"google.golang.org/protobuf/encoding/protojson"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -23,21 +27,37 @@ type Retriever struct {
 	consulClient    *consul.Client
 	signEntsCached  *sync.Map
 	signCheckCached *sync.Map
+	policies        *sync.Map
+	dcHealths       *sync.Map
+	signals         *sync.Map
+	weights         *sync.Map
+	slowStart       *lb.SlowStart
+	lastSignedAts   *sync.Map
 	dcName          string
 	nbWorkers       int
 	interval        time.Duration
 	disableCatPoll  bool
+	signer          *signs.Signer
+	kvSynced        atomic.Bool
+	catalogSynced   atomic.Bool
 }
 
-func NewRetriever(dcName string, nbWorkers int, interval time.Duration, consulClient *consul.Client) *Retriever {
+func NewRetriever(dcName string, nbWorkers int, interval time.Duration, consulClient *consul.Client, signer *signs.Signer) *Retriever {
 	return &Retriever{
 		entry:           log.WithField("component", "retriever"),
 		consulClient:    consulClient,
 		signEntsCached:  &sync.Map{},
 		signCheckCached: &sync.Map{},
+		policies:        &sync.Map{},
+		dcHealths:       &sync.Map{},
+		signals:         &sync.Map{},
+		weights:         &sync.Map{},
+		slowStart:       lb.NewSlowStart(),
+		lastSignedAts:   &sync.Map{},
 		interval:        interval,
 		dcName:          dcName,
 		nbWorkers:       nbWorkers,
+		signer:          signer,
 	}
 }
 
@@ -45,6 +65,16 @@ func (r *Retriever) DisableCatalogPolling() {
 	r.disableCatPoll = true
 }
 
+// Ready tells if a full sync of kv, and catalog when polled, has been made at least once.
+func (r *Retriever) Ready() bool {
+	return r.kvSynced.Load() && (r.disableCatPoll || r.catalogSynced.Load())
+}
+
+// KVSynced tells if a full sync of kv has been made at least once.
+func (r *Retriever) KVSynced() bool {
+	return r.kvSynced.Load()
+}
+
 func (r *Retriever) Run(ctx context.Context) error {
 	r.entry.Info("starting retriever ...")
 	err := r.pollKV()
@@ -111,8 +141,10 @@ func (r *Retriever) runCatalog(ctx context.Context) {
 func (r *Retriever) pollKV() error {
 	r.entry.Info("polling kv ...")
 	defer r.entry.Info("polling kv done.")
+	defer stats.ObservePollDuration(phaseKV, time.Now())
 	kvPairs, _, err := r.consulClient.KV().List(config.ConsulKVEntriesPrefix, &consul.QueryOptions{})
 	if err != nil {
+		stats.AddError(phaseKV)
 		return fmt.Errorf("error while listing kv entries: %s", err)
 	}
 	log.Debugf("found %d kv entries", len(kvPairs))
@@ -128,15 +160,19 @@ func (r *Retriever) pollKV() error {
 		delete(toRemove, fqdn)
 		p.Go(func() {
 			signedEntry := &entries.SignedEntry{}
-			err = protojson.Unmarshal(kvPair.Value, signedEntry)
+			err := protojson.Unmarshal(kvPair.Value, signedEntry)
 			if err != nil {
+				stats.AddError(phaseKV)
 				r.entry.WithError(err).Errorf("error while unmarshalling signed entry for %s", fqdn)
 				return
 			}
+			if !r.verifyEntry(fqdn, signedEntry) {
+				return
+			}
 			rawEntry, loaded := r.signEntsCached.LoadOrStore(fqdn, signedEntry)
 			if !loaded {
 				log.Debugf("emitted signed entry for %s", fqdn)
-				observe.EmitKvEntry(observe.EventTypeSet, signedEntry)
+				r.emitKvEntry(observe.EventTypeSet, signedEntry)
 				return
 			}
 			actualEntry := rawEntry.(*entries.SignedEntry)
@@ -145,23 +181,280 @@ func (r *Retriever) pollKV() error {
 			}
 			r.signEntsCached.Store(fqdn, signedEntry)
 			log.Debugf("emitted signed entry for %s", fqdn)
-			observe.EmitKvEntry(observe.EventTypeSet, signedEntry)
+			r.emitKvEntry(observe.EventTypeSet, signedEntry)
 		})
 	}
 	p.Wait()
 	for fqdn := range toRemove {
 		rawKvEntry, ok := r.signEntsCached.Load(fqdn)
-		entry := rawKvEntry.(*entries.SignedEntry)
-		if ok {
-			observe.EmitKvEntry(observe.EventTypeDelete, entry)
-			r.signEntsCached.Delete(fqdn)
+		if !ok {
+			continue
 		}
+		entry := rawKvEntry.(*entries.SignedEntry)
+		r.emitKvEntry(observe.EventTypeDelete, entry)
+		r.signEntsCached.Delete(fqdn)
 
 		_, ok = r.signCheckCached.Load(fqdn)
 		if ok {
-			observe.EmitCatalogEntry(observe.EventTypeDelete, entry.Entry)
+			r.emitCatalogEntry(observe.EventTypeDelete, entry.Entry)
 			r.signCheckCached.Delete(fqdn)
 		}
+		r.slowStart.Forget(fqdn)
+	}
+
+	nbEntries := 0
+	r.signEntsCached.Range(func(_, _ interface{}) bool {
+		nbEntries++
+		return true
+	})
+	stats.SetEntries(nbEntries)
+
+	err = r.pollPolicies()
+	if err != nil {
+		stats.AddError(phaseKV)
+		return err
+	}
+	err = r.pollDcHealths()
+	if err != nil {
+		stats.AddError(phaseKV)
+		return err
+	}
+	err = r.pollSignals()
+	if err != nil {
+		stats.AddError(phaseKV)
+		return err
+	}
+	err = r.pollWeights()
+	if err != nil {
+		stats.AddError(phaseKV)
+		return err
+	}
+	stats.SetLastSync(phaseKV)
+	r.kvSynced.Store(true)
+	return nil
+}
+
+func (r *Retriever) pollPolicies() error {
+	kvPairs, _, err := r.consulClient.KV().List(config.ConsulKVPoliciesPrefix, &consul.QueryOptions{})
+	if err != nil {
+		return fmt.Errorf("error while listing kv policies: %s", err)
+	}
+	toRemove := map[string]struct{}{}
+	r.policies.Range(func(key, value interface{}) bool {
+		toRemove[key.(string)] = struct{}{}
+		return true
+	})
+	for _, kvPair := range kvPairs {
+		fqdn := dns.CanonicalName(kvPair.Key[len(config.ConsulKVPoliciesPrefix):])
+		policy := &opssvc.EntryPolicy{}
+		err := protojson.Unmarshal(kvPair.Value, policy)
+		if err != nil {
+			r.entry.WithError(err).Errorf("error while unmarshalling policy for %s", fqdn)
+			continue
+		}
+		delete(toRemove, fqdn)
+		r.policies.Store(fqdn, policy)
+	}
+	for fqdn := range toRemove {
+		r.policies.Delete(fqdn)
+	}
+	return nil
+}
+
+// pollDcHealths retrieves health reports made by dcs on members of other dcs, reports are kept by fqdn and dc.
+func (r *Retriever) pollDcHealths() error {
+	kvPairs, _, err := r.consulClient.KV().List(config.ConsulKVDcHealthPrefix, &consul.QueryOptions{})
+	if err != nil {
+		return fmt.Errorf("error while listing kv dc health reports: %s", err)
+	}
+	dcHealths := make(map[string]map[string]*opssvc.DcHealthReport)
+	for _, kvPair := range kvPairs {
+		// key is <fqdn>/<dc>
+		key := kvPair.Key[len(config.ConsulKVDcHealthPrefix):]
+		i := strings.LastIndex(key, "/")
+		if i < 0 {
+			continue
+		}
+		fqdn := dns.CanonicalName(key[:i])
+		report := &opssvc.DcHealthReport{}
+		err := protojson.Unmarshal(kvPair.Value, report)
+		if err != nil {
+			r.entry.WithError(err).Errorf("error while unmarshalling dc health report %s", kvPair.Key)
+			continue
+		}
+		if _, ok := dcHealths[fqdn]; !ok {
+			dcHealths[fqdn] = make(map[string]*opssvc.DcHealthReport)
+		}
+		dcHealths[fqdn][report.GetDc()] = report
+	}
+	r.dcHealths.Range(func(key, value interface{}) bool {
+		if _, ok := dcHealths[key.(string)]; !ok {
+			r.dcHealths.Delete(key)
+		}
+		return true
+	})
+	for fqdn, reports := range dcHealths {
+		r.dcHealths.Store(fqdn, reports)
+	}
+	return nil
+}
+
+// pollSignals retrieves member signals reported by external systems, signals are kept by fqdn and ip.
+func (r *Retriever) pollSignals() error {
+	kvPairs, _, err := r.consulClient.KV().List(config.ConsulKVSignalsPrefix, &consul.QueryOptions{})
+	if err != nil {
+		return fmt.Errorf("error while listing kv member signals: %s", err)
+	}
+	signals := make(map[string]map[string]*opssvc.MemberSignal)
+	for _, kvPair := range kvPairs {
+		signal := &opssvc.MemberSignal{}
+		err := protojson.Unmarshal(kvPair.Value, signal)
+		if err != nil {
+			r.entry.WithError(err).Errorf("error while unmarshalling member signal %s", kvPair.Key)
+			continue
+		}
+		if !r.verifySignal(kvPair.Key, signal) {
+			continue
+		}
+		fqdn := dns.CanonicalName(signal.GetFqdn())
+		if _, ok := signals[fqdn]; !ok {
+			signals[fqdn] = make(map[string]*opssvc.MemberSignal)
+		}
+		signals[fqdn][signal.GetIp()] = signal
+	}
+	r.signals.Range(func(key, value interface{}) bool {
+		if _, ok := signals[key.(string)]; !ok {
+			r.signals.Delete(key)
+		}
+		return true
+	})
+	for fqdn, fqdnSignals := range signals {
+		r.signals.Store(fqdn, fqdnSignals)
+	}
+	return nil
+}
+
+// pollWeights retrieves effective weights computed by dcs for entries using adaptive weights, they are kept by fqdn and dc.
+func (r *Retriever) pollWeights() error {
+	kvPairs, _, err := r.consulClient.KV().List(config.ConsulKVWeightsPrefix, &consul.QueryOptions{})
+	if err != nil {
+		return fmt.Errorf("error while listing kv effective weights: %s", err)
+	}
+	weights := make(map[string]map[string]*opssvc.EffectiveWeights)
+	for _, kvPair := range kvPairs {
+		effectiveWeights := &opssvc.EffectiveWeights{}
+		err := protojson.Unmarshal(kvPair.Value, effectiveWeights)
+		if err != nil {
+			r.entry.WithError(err).Errorf("error while unmarshalling effective weights %s", kvPair.Key)
+			continue
+		}
+		fqdn := dns.CanonicalName(effectiveWeights.GetFqdn())
+		if _, ok := weights[fqdn]; !ok {
+			weights[fqdn] = make(map[string]*opssvc.EffectiveWeights)
+		}
+		weights[fqdn][effectiveWeights.GetDc()] = effectiveWeights
+	}
+	r.weights.Range(func(key, value interface{}) bool {
+		if _, ok := weights[key.(string)]; !ok {
+			r.weights.Delete(key)
+		}
+		return true
+	})
+	for fqdn, fqdnWeights := range weights {
+		r.weights.Store(fqdn, fqdnWeights)
+	}
+	return nil
+}
+
+func (r *Retriever) emitKvEntry(et observe.EventType, signedEntry *entries.SignedEntry) {
+	stats.AddEmittedEvent(phaseKV, et)
+	observe.EmitKvEntry(et, signedEntry)
+}
+
+func (r *Retriever) emitCatalogEntry(et observe.EventType, entry *entries.Entry) {
+	stats.AddEmittedEvent(phaseCatalog, et)
+	observe.EmitCatalogEntry(et, entry)
+}
+
+// verifyEntry tells if entry can be trusted, when signature enforcement is not enabled
+// entries with invalid signature are only reported and still trusted.
+func (r *Retriever) verifyEntry(fqdn string, signedEntry *entries.SignedEntry) bool {
+	if !r.signer.Enabled() {
+		return true
+	}
+	err := r.signer.Verify(signedEntry)
+	if err == nil && signedEntry.GetEntry().GetFqdn() != fqdn {
+		err = &signs.ErrVerify{
+			Reason:  signs.ReasonInvalidSignature,
+			Message: fmt.Sprintf("entry is for %s", signedEntry.GetEntry().GetFqdn()),
+		}
+	}
+	if err == nil {
+		err = r.checkSignedAt(fqdn, signedEntry)
+	}
+	if err == nil {
+		return true
+	}
+	reason := signs.ReasonInvalidSignature
+	if errVerify, ok := err.(*signs.ErrVerify); ok {
+		reason = errVerify.Reason
+	}
+	stats.AddRejectedEntry(reason)
+	if !r.signer.Enforced() {
+		r.entry.WithError(err).Warnf("signed entry for %s can not be verified", fqdn)
+		return true
+	}
+	r.entry.WithError(err).Errorf("signed entry for %s rejected", fqdn)
+	return false
+}
+
+// verifySignal tells if member signal can be trusted, signals have the same signature rules as entries,
+// a signal with an invalid signature is only reported when signature enforcement is not enabled.
+func (r *Retriever) verifySignal(key string, signal *opssvc.MemberSignal) bool {
+	if !r.signer.Enabled() {
+		return true
+	}
+	err := r.signer.VerifySignal(signal)
+	if err == nil && key != config.ConsulKVSignalsPrefix+dns.CanonicalName(signal.GetFqdn())+"/"+signal.GetIp() {
+		err = &signs.ErrVerify{
+			Reason:  signs.ReasonInvalidSignature,
+			Message: fmt.Sprintf("signal is for member %s of %s", signal.GetIp(), signal.GetFqdn()),
+		}
+	}
+	if err == nil {
+		return true
+	}
+	reason := signs.ReasonInvalidSignature
+	if errVerify, ok := err.(*signs.ErrVerify); ok {
+		reason = errVerify.Reason
+	}
+	stats.AddRejectedSignal(reason)
+	if !r.signer.Enforced() {
+		r.entry.WithError(err).Warnf("member signal %s can not be verified", key)
+		return true
+	}
+	r.entry.WithError(err).Errorf("member signal %s rejected", key)
+	return false
+}
+
+// checkSignedAt rejects entries signed before the last one seen for the fqdn,
+// an older revision written back in kv would be authenticated otherwise.
+// Last signing times are kept after entry removal to also reject an older revision recreating entry.
+func (r *Retriever) checkSignedAt(fqdn string, signedEntry *entries.SignedEntry) error {
+	signedAt := signs.SignedAt(signedEntry)
+	rawLast, loaded := r.lastSignedAts.LoadOrStore(fqdn, signedAt)
+	if !loaded {
+		return nil
+	}
+	last := rawLast.(time.Time)
+	if signedAt.Add(r.signer.MaxClockSkew()).Before(last) {
+		return &signs.ErrVerify{
+			Reason:  signs.ReasonStaleSignature,
+			Message: fmt.Sprintf("entry has been signed at %s before last seen signature made at %s", signedAt, last),
+		}
+	}
+	if signedAt.After(last) {
+		r.lastSignedAts.Store(fqdn, signedAt)
 	}
 	return nil
 }
@@ -169,6 +462,9 @@ func (r *Retriever) pollKV() error {
 func (r *Retriever) pollCatalog() error {
 	r.entry.Info("polling catalog ...")
 	defer r.entry.Info("polling catalog done.")
+	defer stats.ObservePollDuration(phaseCatalog, time.Now())
+	// catalog entries are only known for entries found in kv, a full sync needs kv to be synced first
+	kvSynced := r.kvSynced.Load()
 
 	svcs, _, err := r.consulClient.Catalog().Services(&consul.QueryOptions{
 		Filter: fmt.Sprintf("ServiceMeta.%s == true and ServiceMeta.%s == %s",
@@ -178,6 +474,7 @@ func (r *Retriever) pollCatalog() error {
 		),
 	})
 	if err != nil {
+		stats.AddError(phaseCatalog)
 		return fmt.Errorf("error while listing catalog entries: %s", err)
 	}
 	log.Debugf("found %d catalog entries", len(svcs))
@@ -206,6 +503,7 @@ func (r *Retriever) pollCatalog() error {
 
 			ents, _, err := r.consulClient.Health().Service(fqdn, "", true, &consul.QueryOptions{})
 			if err != nil {
+				stats.AddError(phaseCatalog)
 				r.entry.WithError(err).Errorf("error while listing health entries for service %s", fqdn)
 				return
 			}
@@ -219,20 +517,50 @@ func (r *Retriever) pollCatalog() error {
 				}
 				membersIpv4 = append(membersIpv4, member)
 			}
-			signedEntry.Entry.MembersIpv4 = membersIpv4
-			signedEntry.Entry.MembersIpv6 = membersIpv6
+			signals := r.GetMemberSignals(fqdn)
+			weights := r.GetEffectiveWeights(fqdn)
+			signedEntry.Entry.MembersIpv4 = applyWeights(applySignals(membersIpv4, signals), weights)
+			signedEntry.Entry.MembersIpv6 = applyWeights(applySignals(membersIpv6, signals), weights)
+			policy := r.GetPolicy(fqdn)
+			slowStart := policy.GetSlowStart()
+			window := slowStart.GetWindow().AsDuration()
+			if policy.GetClientAffinity() != nil {
+				// ratios are kept for consistent hash, clients would be remapped during ramp otherwise,
+				// transitions are still recorded
+				window = 0
+			}
+			ramped := r.slowStart.Ramp(
+				fqdn, window, slowStart.GetMinPercent(),
+				signedEntry.Entry.MembersIpv4, signedEntry.Entry.MembersIpv6,
+			)
+			signedEntry.Entry.MembersIpv4, signedEntry.Entry.MembersIpv6 = ramped[0], ramped[1]
 
 			newSig, err := helpers.MessageSignature(signedEntry)
 			if err != nil {
+				stats.AddError(phaseCatalog)
 				r.entry.WithError(err).Errorf("error while signing entry for %s", fqdn)
 				return
 			}
+			// priority groups are computed from policy and all members of kv entry by the dns handler
+			// and client affinity replaces preferred lb, catalog entry must be emitted again when they change
+			if policy.GetPriorityGroups() != nil || policy.GetClientAffinity() != nil {
+				policySig, err := helpers.MessageSignature(&opssvc.EntryPolicy{
+					PriorityGroups: policy.GetPriorityGroups(),
+					ClientAffinity: policy.GetClientAffinity(),
+				})
+				if err != nil {
+					stats.AddError(phaseCatalog)
+					r.entry.WithError(err).Errorf("error while signing policy for %s", fqdn)
+					return
+				}
+				newSig = fmt.Sprintf("%s-%s-%s", newSig, policySig, rawEntry.(*entries.SignedEntry).GetSignature())
+			}
 
 			signedEntry.Signature = newSig
 			rawSign, loaded := r.signCheckCached.LoadOrStore(fqdn, newSig)
 			if !loaded {
 				log.Debugf("emitted catalog entry for %s", fqdn)
-				observe.EmitCatalogEntry(observe.EventTypeSet, signedEntry.Entry)
+				r.emitCatalogEntry(observe.EventTypeSet, signedEntry.Entry)
 				return
 			}
 			if rawSign.(string) == newSig {
@@ -241,10 +569,14 @@ func (r *Retriever) pollCatalog() error {
 
 			r.signCheckCached.Store(fqdn, newSig)
 			log.Tracef("emitted catalog entry for %s (old sign: %s - new sign: %s )", fqdn, rawSign.(string), newSig)
-			observe.EmitCatalogEntry(observe.EventTypeSet, signedEntry.Entry)
+			r.emitCatalogEntry(observe.EventTypeSet, signedEntry.Entry)
 		})
 	}
 	p.Wait()
+	stats.SetLastSync(phaseCatalog)
+	if kvSynced {
+		r.catalogSynced.Store(true)
+	}
 	return nil
 }
 
@@ -271,6 +603,95 @@ func (r *Retriever) GetEntry(fqdn string) (*entries.SignedEntry, bool) {
 	return rawEntry.(*entries.SignedEntry), true
 }
 
+// GetPolicy gives policy set on entry, an empty policy is given when none has been set.
+func (r *Retriever) GetPolicy(fqdn string) *opssvc.EntryPolicy {
+	rawPolicy, ok := r.policies.Load(fqdn)
+	if !ok {
+		return &opssvc.EntryPolicy{
+			Fqdn: fqdn,
+		}
+	}
+	return rawPolicy.(*opssvc.EntryPolicy)
+}
+
+// GetDcHealthReports gives health reports by dc made on members of entry by dcs which are not theirs.
+func (r *Retriever) GetDcHealthReports(fqdn string) map[string]*opssvc.DcHealthReport {
+	rawReports, ok := r.dcHealths.Load(fqdn)
+	if !ok {
+		return map[string]*opssvc.DcHealthReport{}
+	}
+	return rawReports.(map[string]*opssvc.DcHealthReport)
+}
+
+// GetMemberSignals gives signals by ip reported on members of entry which are not expired.
+func (r *Retriever) GetMemberSignals(fqdn string) map[string]*opssvc.MemberSignal {
+	rawSignals, ok := r.signals.Load(fqdn)
+	if !ok {
+		return map[string]*opssvc.MemberSignal{}
+	}
+	now := time.Now()
+	signals := make(map[string]*opssvc.MemberSignal)
+	for ip, signal := range rawSignals.(map[string]*opssvc.MemberSignal) {
+		if signal.GetExpiresAt().AsTime().After(now) {
+			signals[ip] = signal
+		}
+	}
+	return signals
+}
+
+// applySignals removes members having a signal, members reported as degraded are
+// only kept when no member without signal is left.
+func applySignals(members []*entries.Member, signals map[string]*opssvc.MemberSignal) []*entries.Member {
+	if len(signals) == 0 {
+		return members
+	}
+	healthy := make([]*entries.Member, 0, len(members))
+	degraded := make([]*entries.Member, 0)
+	for _, member := range members {
+		signal, ok := signals[member.GetIp()]
+		if !ok {
+			healthy = append(healthy, member)
+			continue
+		}
+		if signal.GetLevel() == opssvc.MemberSignal_DEGRADED {
+			degraded = append(degraded, member)
+		}
+	}
+	if len(healthy) > 0 {
+		return healthy
+	}
+	return degraded
+}
+
+// GetEffectiveWeights gives weights by member ip computed for entry which are not expired.
+func (r *Retriever) GetEffectiveWeights(fqdn string) map[string]uint32 {
+	rawWeights, ok := r.weights.Load(fqdn)
+	if !ok {
+		return map[string]uint32{}
+	}
+	now := time.Now()
+	weights := make(map[string]uint32)
+	for _, effectiveWeights := range rawWeights.(map[string]*opssvc.EffectiveWeights) {
+		if !effectiveWeights.GetExpiresAt().AsTime().After(now) {
+			continue
+		}
+		for _, memberWeight := range effectiveWeights.GetMembers() {
+			weights[memberWeight.GetIp()] = memberWeight.GetWeight()
+		}
+	}
+	return weights
+}
+
+// applyWeights replaces static ratio of members by their effective weight when one has been computed.
+func applyWeights(members []*entries.Member, weights map[string]uint32) []*entries.Member {
+	for _, member := range members {
+		if weight, ok := weights[member.GetIp()]; ok {
+			member.Ratio = weight
+		}
+	}
+	return members
+}
+
 func (r *Retriever) consulEntryToMember(consulEnt *consul.ServiceEntry) *entries.Member {
 	ratio := 0
 	dc := r.dcName
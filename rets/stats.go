@@ -0,0 +1,129 @@
+package rets
+
+import (
+	"github.com/orange-cloudfoundry/gsloc/observe"
+	"github.com/prometheus/client_golang/prometheus"
+	"time"
+)
+
+const (
+	phaseKV      = "kv"
+	phaseCatalog = "catalog"
+)
+
+var stats = metrics{
+	rejectedEntries: prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gsloc",
+		Subsystem: "retriever",
+		Name:      "rejected_entries",
+		Help:      "Number of kv entries rejected because their signature can not be verified",
+	}, []string{
+		"reason",
+	}),
+
+	rejectedSignals: prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gsloc",
+		Subsystem: "retriever",
+		Name:      "rejected_signals",
+		Help:      "Number of kv member signals rejected because their signature can not be verified",
+	}, []string{
+		"reason",
+	}),
+
+	pollDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "gsloc",
+		Subsystem: "retriever",
+		Name:      "poll_duration_seconds",
+		Help:      "Duration of a poll of consul kv or catalog",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{
+		"phase",
+	}),
+
+	lastSync: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "gsloc",
+		Subsystem: "retriever",
+		Name:      "last_sync_timestamp_seconds",
+		Help:      "Unix timestamp of the last successful poll of consul kv or catalog",
+	}, []string{
+		"phase",
+	}),
+
+	entries: prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "gsloc",
+		Subsystem: "retriever",
+		Name:      "entries",
+		Help:      "Number of kv entries known by the retriever",
+	}),
+
+	emittedEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gsloc",
+		Subsystem: "retriever",
+		Name:      "emitted_events",
+		Help:      "Number of set or delete events emitted from consul kv or catalog",
+	}, []string{
+		"phase",
+		"type",
+	}),
+
+	errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gsloc",
+		Subsystem: "retriever",
+		Name:      "errors",
+		Help:      "Number of errors while polling consul kv or catalog",
+	}, []string{
+		"phase",
+	}),
+}
+
+type metrics struct {
+	rejectedEntries *prometheus.CounterVec
+	rejectedSignals *prometheus.CounterVec
+	pollDuration    *prometheus.HistogramVec
+	lastSync        *prometheus.GaugeVec
+	entries         prometheus.Gauge
+	emittedEvents   *prometheus.CounterVec
+	errors          *prometheus.CounterVec
+}
+
+func init() {
+	prometheus.MustRegister(stats.rejectedEntries)
+	prometheus.MustRegister(stats.rejectedSignals)
+	prometheus.MustRegister(stats.pollDuration)
+	prometheus.MustRegister(stats.lastSync)
+	prometheus.MustRegister(stats.entries)
+	prometheus.MustRegister(stats.emittedEvents)
+	prometheus.MustRegister(stats.errors)
+}
+
+func (m *metrics) AddRejectedEntry(reason string) {
+	m.rejectedEntries.WithLabelValues(reason).Add(1)
+}
+
+func (m *metrics) AddRejectedSignal(reason string) {
+	m.rejectedSignals.WithLabelValues(reason).Add(1)
+}
+
+func (m *metrics) ObservePollDuration(phase string, since time.Time) {
+	m.pollDuration.WithLabelValues(phase).Observe(time.Since(since).Seconds())
+}
+
+func (m *metrics) SetLastSync(phase string) {
+	m.lastSync.WithLabelValues(phase).SetToCurrentTime()
+}
+
+func (m *metrics) SetEntries(nb int) {
+	m.entries.Set(float64(nb))
+}
+
+func (m *metrics) AddEmittedEvent(phase string, et observe.EventType) {
+	eventType := "set"
+	if et == observe.EventTypeDelete {
+		eventType = "delete"
+	}
+	m.emittedEvents.WithLabelValues(phase, eventType).Add(1)
+}
+
+func (m *metrics) AddError(phase string) {
+	m.errors.WithLabelValues(phase).Add(1)
+}
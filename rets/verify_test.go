@@ -0,0 +1,165 @@
+package rets
+
+import (
+	"fmt"
+	"github.com/onsi/gomega"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"github.com/orange-cloudfoundry/gsloc/signs"
+	"testing"
+	"time"
+)
+
+func newTestRetriever(enforce bool, maxClockSkew time.Duration) (*Retriever, *signs.Signer) {
+	skew := config.Duration(maxClockSkew)
+	signer := signs.NewSigner(&config.SigningConfig{
+		SignKeyId: "key",
+		Enforce:   enforce,
+		Keys: []*config.SigningKey{
+			{
+				Id:     "key",
+				Type:   config.SigningKeyTypeHmac,
+				Secret: "secret",
+			},
+		},
+		MaxClockSkew: &skew,
+	})
+	return NewRetriever("dc1", 1, time.Minute, nil, signer), signer
+}
+
+func signedTestEntry(fqdn string, signedAt time.Time) *entries.SignedEntry {
+	return &entries.SignedEntry{
+		Entry: &entries.Entry{
+			Fqdn: fqdn,
+		},
+		Signature: fmt.Sprintf("hmac:key:%d:c2ln", signedAt.UnixNano()),
+	}
+}
+
+func TestVerifyEntry(t *testing.T) {
+	g := gomega.NewWithT(t)
+	r, signer := newTestRetriever(true, 0)
+
+	signedEnt := &entries.SignedEntry{
+		Entry: &entries.Entry{
+			Fqdn: "app.example.com.",
+		},
+	}
+	g.Expect(signer.Sign(signedEnt)).To(gomega.Succeed())
+	g.Expect(r.verifyEntry("app.example.com.", signedEnt)).To(gomega.BeTrue())
+
+	// a valid entry copied under another fqdn must not be trusted
+	g.Expect(r.verifyEntry("other.example.com.", signedEnt)).To(gomega.BeFalse())
+
+	signedEnt.Entry.Tags = []string{"changed"}
+	g.Expect(r.verifyEntry("app.example.com.", signedEnt)).To(gomega.BeFalse())
+
+	signedEnt.Signature = ""
+	g.Expect(r.verifyEntry("app.example.com.", signedEnt)).To(gomega.BeFalse())
+}
+
+func TestVerifyEntryNotEnforced(t *testing.T) {
+	g := gomega.NewWithT(t)
+	r, _ := newTestRetriever(false, 0)
+
+	g.Expect(r.verifyEntry("app.example.com.", &entries.SignedEntry{
+		Entry: &entries.Entry{
+			Fqdn: "app.example.com.",
+		},
+	})).To(gomega.BeTrue())
+}
+
+func TestVerifyEntryRejectsStaleEntry(t *testing.T) {
+	g := gomega.NewWithT(t)
+	r, signer := newTestRetriever(true, 0)
+
+	older := &entries.SignedEntry{
+		Entry: &entries.Entry{
+			Fqdn: "app.example.com.",
+			Tags: []string{"older"},
+		},
+	}
+	g.Expect(signer.Sign(older)).To(gomega.Succeed())
+	time.Sleep(time.Millisecond)
+	newer := &entries.SignedEntry{
+		Entry: &entries.Entry{
+			Fqdn: "app.example.com.",
+			Tags: []string{"newer"},
+		},
+	}
+	g.Expect(signer.Sign(newer)).To(gomega.Succeed())
+
+	g.Expect(r.verifyEntry("app.example.com.", newer)).To(gomega.BeTrue())
+	// older entry written back is rejected even if its signature is valid
+	g.Expect(r.verifyEntry("app.example.com.", older)).To(gomega.BeFalse())
+	g.Expect(r.verifyEntry("app.example.com.", newer)).To(gomega.BeTrue())
+}
+
+func TestCheckSignedAt(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		name     string
+		previous []time.Time
+		signedAt time.Time
+		stale    bool
+	}{
+		{
+			name:     "first seen",
+			signedAt: now,
+		},
+		{
+			name:     "same signature",
+			previous: []time.Time{now},
+			signedAt: now,
+		},
+		{
+			name:     "newer signature",
+			previous: []time.Time{now},
+			signedAt: now.Add(time.Minute),
+		},
+		{
+			name:     "older signature within clock skew",
+			previous: []time.Time{now},
+			signedAt: now.Add(-30 * time.Second),
+		},
+		{
+			name:     "older signature beyond clock skew",
+			previous: []time.Time{now},
+			signedAt: now.Add(-2 * time.Minute),
+			stale:    true,
+		},
+		{
+			name:     "compared to last newest signature",
+			previous: []time.Time{now, now.Add(2 * time.Minute), now.Add(time.Minute)},
+			signedAt: now.Add(30 * time.Second),
+			stale:    true,
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			r, _ := newTestRetriever(true, time.Minute)
+			for _, previous := range c.previous {
+				g.Expect(r.checkSignedAt("app.example.com.", signedTestEntry("app.example.com.", previous))).To(gomega.Succeed())
+			}
+			err := r.checkSignedAt("app.example.com.", signedTestEntry("app.example.com.", c.signedAt))
+			if !c.stale {
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).To(gomega.HaveOccurred())
+			g.Expect(err.(*signs.ErrVerify).Reason).To(gomega.Equal(signs.ReasonStaleSignature))
+		})
+	}
+}
+
+func TestCheckSignedAtByFqdn(t *testing.T) {
+	g := gomega.NewWithT(t)
+	r, _ := newTestRetriever(true, 0)
+	now := time.Now()
+
+	g.Expect(r.checkSignedAt("app.example.com.", signedTestEntry("app.example.com.", now))).To(gomega.Succeed())
+	// signing time of another entry does not matter
+	g.Expect(r.checkSignedAt("other.example.com.", signedTestEntry("other.example.com.", now.Add(-time.Hour)))).To(gomega.Succeed())
+}
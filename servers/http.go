@@ -6,6 +6,7 @@ import (
 	"github.com/orange-cloudfoundry/gsloc/config"
 	"github.com/orange-cloudfoundry/gsloc/healthchecks"
 	"github.com/orange-cloudfoundry/gsloc/proxmetrics"
+	"github.com/orange-cloudfoundry/gsloc/rets"
 	"google.golang.org/grpc"
 	"net/http"
 	"strings"
@@ -22,6 +23,8 @@ type HTTPServer struct {
 	grpcServ       *grpc.Server
 	metricsFetcher *proxmetrics.Fetcher
 	statusHandler  *proxmetrics.StatusHandler
+	retriever      *rets.Retriever
+	signalWebhook  http.Handler
 }
 
 func NewHTTPServer(
@@ -30,6 +33,8 @@ func NewHTTPServer(
 	grpcServ *grpc.Server,
 	metricsFetcher *proxmetrics.Fetcher,
 	statusHandler *proxmetrics.StatusHandler,
+	retriever *rets.Retriever,
+	signalWebhook http.Handler,
 ) *HTTPServer {
 	return &HTTPServer{
 		mux:            mux.NewRouter(),
@@ -38,6 +43,8 @@ func NewHTTPServer(
 		grpcServ:       grpcServ,
 		metricsFetcher: metricsFetcher,
 		statusHandler:  statusHandler,
+		retriever:      retriever,
+		signalWebhook:  signalWebhook,
 	}
 }
 
@@ -46,7 +53,7 @@ func (s *HTTPServer) ServeHTTP(writer http.ResponseWriter, request *http.Request
 		s.mux.ServeHTTP(writer, request)
 		return
 	}
-	if strings.Contains(request.Header.Get("Content-Type"), "application/grpc") {
+	if s.grpcServ != nil && strings.Contains(request.Header.Get("Content-Type"), "application/grpc") {
 		s.grpcServ.ServeHTTP(writer, request)
 		return
 	}
@@ -55,12 +62,25 @@ func (s *HTTPServer) ServeHTTP(writer http.ResponseWriter, request *http.Request
 
 func (s *HTTPServer) Run(ctx context.Context) {
 	s.mux.Path("/metrics").Handler(s.metricsFetcher)
-	s.mux.Path("/metrics/status").Handler(s.statusHandler)
-	s.mux.Methods("POST").Path("/hc/{fqdn}/member/{ip}").Handler(s.hcker)
+	s.mux.Methods("GET").Path("/ready").HandlerFunc(s.ready)
+	if s.statusHandler != nil {
+		s.mux.Path("/metrics/status").Handler(s.statusHandler)
+	}
+	if s.hcker != nil {
+		s.mux.Methods("POST").Path("/hc/{fqdn}/member/{ip}").Handler(s.hcker)
+	}
+	if s.signalWebhook != nil {
+		s.mux.Methods("POST").Path("/signals/{fqdn}/member/{ip}").Handler(s.signalWebhook)
+	}
 
+	tlsConf, err := MakeServerTLSConfig(s.cnf.TLSPem)
+	if err != nil {
+		log.Fatalf("tls config: %s\n", err.Error())
+	}
 	srvTls := &http.Server{
-		Addr:    s.cnf.Listen,
-		Handler: s,
+		Addr:      s.cnf.Listen,
+		Handler:   s,
+		TLSConfig: tlsConf,
 	}
 	var srvLocal *http.Server
 	if s.cnf.ListenLocalPort != 0 {
@@ -79,7 +99,7 @@ func (s *HTTPServer) Run(ctx context.Context) {
 	}
 	go func() {
 		log.Infof("Starting https server https://%s ...", s.cnf.Listen)
-		err := srvTls.ListenAndServeTLS(s.cnf.TLSPem.CertPath, s.cnf.TLSPem.PrivateKeyPath)
+		err := srvTls.ListenAndServeTLS("", "")
 		if err != nil && err != http.ErrServerClosed {
 			log.Fatalf("listen: %s\n", err.Error())
 		}
@@ -98,9 +118,20 @@ func (s *HTTPServer) Run(ctx context.Context) {
 			log.Info("Finished graceful shutdown http server.")
 		}()
 	}
-	err := srvTls.Shutdown(ctxTimeout)
+	err = srvTls.Shutdown(ctxTimeout)
 	if err != nil {
 		log.Errorf("error when shutdown https: %s", err.Error())
 	}
 	log.Info("Finished graceful shutdown https server.")
 }
+
+// ready answers with status 200 only when first full sync of consul kv and catalog has been made.
+func (s *HTTPServer) ready(w http.ResponseWriter, _ *http.Request) {
+	if !s.retriever.Ready() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("not ready: waiting for first sync of consul kv and catalog\n")) // nolint:errcheck
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ready\n")) // nolint:errcheck
+}
@@ -0,0 +1,36 @@
+package servers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"os"
+)
+
+// MakeServerTLSConfig gives tls config of gsloc api server.
+// Client certificates are verified against client ca when given, they are not required to keep health checks
+// and webhooks reachable, callers without certificate are then identified by their remote address.
+func MakeServerTLSConfig(cnf config.TLSPem) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cnf.CertPath, cnf.PrivateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("load server certificate: %w", err)
+	}
+	tlsConf := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	}
+	if cnf.ClientCaPath == "" {
+		return tlsConf, nil
+	}
+	b, err := os.ReadFile(cnf.ClientCaPath)
+	if err != nil {
+		return nil, fmt.Errorf("read client ca: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no certificate found in %s", cnf.ClientCaPath)
+	}
+	tlsConf.ClientCAs = pool
+	tlsConf.ClientAuth = tls.VerifyClientCertIfGiven
+	return tlsConf, nil
+}
@@ -0,0 +1,209 @@
+package signs
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/helpers"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ReasonUnsigned         = "unsigned"
+	ReasonUnknownKey       = "unknown_key"
+	ReasonInvalidSignature = "invalid_signature"
+	ReasonStaleSignature   = "stale_signature"
+)
+
+// ErrVerify is returned when a signed entry can not be authenticated.
+type ErrVerify struct {
+	Reason  string
+	Message string
+}
+
+func (e ErrVerify) Error() string {
+	return fmt.Sprintf("%s: %s", e.Reason, e.Message)
+}
+
+// Signer sign and verify signed entries and member signals with keys from configuration.
+// Signature has form <key type>:<key id>:<signing time in unix nanoseconds>:<base64 signature> when keys are configured,
+// otherwise a simple content hash is used as signature, which is only useful for change detection.
+// Signing time is part of signed payload, it lets verifiers reject an older signed entry written back.
+type Signer struct {
+	cnf *config.SigningConfig
+}
+
+func NewSigner(cnf *config.SigningConfig) *Signer {
+	return &Signer{
+		cnf: cnf,
+	}
+}
+
+func (s *Signer) Enabled() bool {
+	return s.cnf != nil
+}
+
+func (s *Signer) Enforced() bool {
+	return s.cnf != nil && s.cnf.Enforce
+}
+
+func (s *Signer) CanSign() bool {
+	return s.cnf == nil || s.cnf.SignKeyId != ""
+}
+
+func (s *Signer) Sign(entry *entries.SignedEntry) error {
+	if s.cnf == nil {
+		sig, err := helpers.MessageSignature(entry)
+		if err != nil {
+			return err
+		}
+		entry.Signature = sig
+		return nil
+	}
+	toSign := proto.Clone(entry).(*entries.SignedEntry)
+	toSign.Signature = ""
+	sig, err := s.sign(toSign)
+	if err != nil {
+		return err
+	}
+	entry.Signature = sig
+	return nil
+}
+
+func (s *Signer) Verify(entry *entries.SignedEntry) error {
+	if s.cnf == nil {
+		return nil
+	}
+	toVerify := proto.Clone(entry).(*entries.SignedEntry)
+	toVerify.Signature = ""
+	return s.verify("entry", entry.GetSignature(), toVerify)
+}
+
+// SignSignal signs a member signal, signals are not signed when no keys are configured.
+func (s *Signer) SignSignal(signal *opssvc.MemberSignal) error {
+	if s.cnf == nil {
+		return nil
+	}
+	toSign := proto.Clone(signal).(*opssvc.MemberSignal)
+	toSign.Signature = ""
+	sig, err := s.sign(toSign)
+	if err != nil {
+		return err
+	}
+	signal.Signature = sig
+	return nil
+}
+
+func (s *Signer) VerifySignal(signal *opssvc.MemberSignal) error {
+	if s.cnf == nil {
+		return nil
+	}
+	toVerify := proto.Clone(signal).(*opssvc.MemberSignal)
+	toVerify.Signature = ""
+	return s.verify("signal", signal.GetSignature(), toVerify)
+}
+
+// sign gives signature of message, message must have its signature field empty.
+func (s *Signer) sign(msg proto.Message) (string, error) {
+	if s.cnf.SignKeyId == "" {
+		return "", fmt.Errorf("no signing key configured")
+	}
+	key := s.cnf.FindKey(s.cnf.SignKeyId)
+	signedAt := time.Now().UnixNano()
+	payload, err := signPayload(msg, signedAt)
+	if err != nil {
+		return "", err
+	}
+	var sig []byte
+	switch key.Type {
+	case config.SigningKeyTypeHmac:
+		sig = hmacSum(key.Secret, payload)
+	case config.SigningKeyTypeEd25519:
+		sig = ed25519.Sign(key.Ed25519Private, payload)
+	}
+	return fmt.Sprintf("%s:%s:%d:%s", key.Type, key.Id, signedAt, base64.StdEncoding.EncodeToString(sig)), nil
+}
+
+// verify checks signature of message, message must have its signature field empty, kind names message in errors.
+func (s *Signer) verify(kind, signature string, msg proto.Message) error {
+	parts := strings.SplitN(signature, ":", 4)
+	if len(parts) == 3 {
+		return &ErrVerify{Reason: ReasonInvalidSignature, Message: fmt.Sprintf("signature has no signing time, %s must be signed again", kind)}
+	}
+	if len(parts) != 4 {
+		return &ErrVerify{Reason: ReasonUnsigned, Message: fmt.Sprintf("%s has no authenticated signature", kind)}
+	}
+	key := s.cnf.FindKey(parts[1])
+	if key == nil || key.Type != parts[0] {
+		return &ErrVerify{Reason: ReasonUnknownKey, Message: fmt.Sprintf("key %s of type %s is unknown", parts[1], parts[0])}
+	}
+	signedAt, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return &ErrVerify{Reason: ReasonInvalidSignature, Message: "signing time is not valid"}
+	}
+	sig, err := base64.StdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return &ErrVerify{Reason: ReasonInvalidSignature, Message: "signature is not valid base64"}
+	}
+	payload, err := signPayload(msg, signedAt)
+	if err != nil {
+		return err
+	}
+	valid := false
+	switch key.Type {
+	case config.SigningKeyTypeHmac:
+		valid = hmac.Equal(sig, hmacSum(key.Secret, payload))
+	case config.SigningKeyTypeEd25519:
+		valid = key.Ed25519Public != nil && ed25519.Verify(key.Ed25519Public, payload, sig)
+	}
+	if !valid {
+		return &ErrVerify{Reason: ReasonInvalidSignature, Message: fmt.Sprintf("signature does not match with key %s", key.Id)}
+	}
+	return nil
+}
+
+// MaxClockSkew gives how much older than the last one seen a signature of an entry can be,
+// it lets entries be written by api nodes with slightly different clocks.
+func (s *Signer) MaxClockSkew() time.Duration {
+	if s.cnf == nil || s.cnf.MaxClockSkew == nil {
+		return 0
+	}
+	return time.Duration(*s.cnf.MaxClockSkew)
+}
+
+// SignedAt gives signing time found in signature of entry, zero time is given when entry is not signed with a key.
+func SignedAt(entry *entries.SignedEntry) time.Time {
+	parts := strings.SplitN(entry.GetSignature(), ":", 4)
+	if len(parts) != 4 {
+		return time.Time{}
+	}
+	signedAt, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(0, signedAt)
+}
+
+func signPayload(msg proto.Message, signedAt int64) ([]byte, error) {
+	payload, err := proto.MarshalOptions{
+		Deterministic: true,
+	}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return append(payload, []byte(fmt.Sprintf("\n%d", signedAt))...), nil
+}
+
+func hmacSum(secret string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,237 @@
+package signs
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"github.com/onsi/gomega"
+	"github.com/orange-cloudfoundry/gsloc-go-sdk/gsloc/api/config/entries/v1"
+	opssvc "github.com/orange-cloudfoundry/gsloc/api/gsloc/services/ops/v1"
+	"github.com/orange-cloudfoundry/gsloc/config"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testSigningKeys(t *testing.T) map[string]*config.SigningKey {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ed25519 key: %v", err)
+	}
+	return map[string]*config.SigningKey{
+		config.SigningKeyTypeHmac: {
+			Id:     "hmac-key",
+			Type:   config.SigningKeyTypeHmac,
+			Secret: "secret",
+		},
+		config.SigningKeyTypeEd25519: {
+			Id:             "ed25519-key",
+			Type:           config.SigningKeyTypeEd25519,
+			Ed25519Private: priv,
+			Ed25519Public:  pub,
+		},
+	}
+}
+
+func testSignedEntry(fqdn string) *entries.SignedEntry {
+	return &entries.SignedEntry{
+		Entry: &entries.Entry{
+			Fqdn: fqdn,
+			MembersIpv4: []*entries.Member{
+				{Ip: "10.0.0.1", Dc: "dc1", Ratio: 1},
+			},
+		},
+	}
+}
+
+func expectVerifyReason(g *gomega.WithT, err error, reason string) {
+	var errVerify *ErrVerify
+	g.Expect(errors.As(err, &errVerify)).To(gomega.BeTrue(), "error %v is not a verify error", err)
+	g.Expect(errVerify.Reason).To(gomega.Equal(reason))
+}
+
+func TestSignerRoundTrip(t *testing.T) {
+	for keyType, key := range testSigningKeys(t) {
+		key := key
+		t.Run(keyType, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			signer := NewSigner(&config.SigningConfig{
+				SignKeyId: key.Id,
+				Keys:      []*config.SigningKey{key},
+			})
+
+			entry := testSignedEntry("app.example.com.")
+			g.Expect(signer.Sign(entry)).To(gomega.Succeed())
+			g.Expect(entry.GetSignature()).To(gomega.HavePrefix(keyType + ":" + key.Id + ":"))
+			g.Expect(signer.Verify(entry)).To(gomega.Succeed())
+			g.Expect(SignedAt(entry)).To(gomega.BeTemporally("~", time.Now(), time.Minute))
+
+			signal := &opssvc.MemberSignal{
+				Fqdn:   "app.example.com.",
+				Ip:     "10.0.0.1",
+				Level:  opssvc.MemberSignal_DEGRADED,
+				Reason: "slow",
+			}
+			g.Expect(signer.SignSignal(signal)).To(gomega.Succeed())
+			g.Expect(signer.VerifySignal(signal)).To(gomega.Succeed())
+		})
+	}
+}
+
+func TestSignerVerifyWithPublicKeyOnly(t *testing.T) {
+	g := gomega.NewWithT(t)
+	key := testSigningKeys(t)[config.SigningKeyTypeEd25519]
+	signer := NewSigner(&config.SigningConfig{
+		SignKeyId: key.Id,
+		Keys:      []*config.SigningKey{key},
+	})
+	verifier := NewSigner(&config.SigningConfig{
+		Keys: []*config.SigningKey{
+			{
+				Id:            key.Id,
+				Type:          key.Type,
+				Ed25519Public: key.Ed25519Public,
+			},
+		},
+	})
+	g.Expect(verifier.CanSign()).To(gomega.BeFalse())
+
+	entry := testSignedEntry("app.example.com.")
+	g.Expect(signer.Sign(entry)).To(gomega.Succeed())
+	g.Expect(verifier.Verify(entry)).To(gomega.Succeed())
+}
+
+func TestSignerRejects(t *testing.T) {
+	keys := testSigningKeys(t)
+	hmacKey := keys[config.SigningKeyTypeHmac]
+	signer := NewSigner(&config.SigningConfig{
+		SignKeyId: hmacKey.Id,
+		Keys:      []*config.SigningKey{hmacKey, keys[config.SigningKeyTypeEd25519]},
+	})
+
+	cases := []struct {
+		name     string
+		verifier *Signer
+		alter    func(entry *entries.SignedEntry)
+		reason   string
+	}{
+		{
+			name:     "changed fqdn",
+			verifier: signer,
+			alter: func(entry *entries.SignedEntry) {
+				entry.Entry.Fqdn = "other.example.com."
+			},
+			reason: ReasonInvalidSignature,
+		},
+		{
+			name:     "changed member",
+			verifier: signer,
+			alter: func(entry *entries.SignedEntry) {
+				entry.Entry.MembersIpv4[0].Ratio = 10
+			},
+			reason: ReasonInvalidSignature,
+		},
+		{
+			name:     "changed signing time",
+			verifier: signer,
+			alter: func(entry *entries.SignedEntry) {
+				parts := strings.SplitN(entry.GetSignature(), ":", 4)
+				parts[2] = "1"
+				entry.Signature = strings.Join(parts, ":")
+			},
+			reason: ReasonInvalidSignature,
+		},
+		{
+			name:     "unsigned",
+			verifier: signer,
+			alter: func(entry *entries.SignedEntry) {
+				entry.Signature = ""
+			},
+			reason: ReasonUnsigned,
+		},
+		{
+			name:     "signature without signing time",
+			verifier: signer,
+			alter: func(entry *entries.SignedEntry) {
+				entry.Signature = "hmac:hmac-key:c2ln"
+			},
+			reason: ReasonInvalidSignature,
+		},
+		{
+			name: "unknown key",
+			verifier: NewSigner(&config.SigningConfig{
+				Keys: []*config.SigningKey{keys[config.SigningKeyTypeEd25519]},
+			}),
+			reason: ReasonUnknownKey,
+		},
+		{
+			name: "key with same id and another type",
+			verifier: NewSigner(&config.SigningConfig{
+				Keys: []*config.SigningKey{
+					{
+						Id:            hmacKey.Id,
+						Type:          config.SigningKeyTypeEd25519,
+						Ed25519Public: keys[config.SigningKeyTypeEd25519].Ed25519Public,
+					},
+				},
+			}),
+			reason: ReasonUnknownKey,
+		},
+		{
+			name: "key with same id and another secret",
+			verifier: NewSigner(&config.SigningConfig{
+				Keys: []*config.SigningKey{
+					{
+						Id:     hmacKey.Id,
+						Type:   config.SigningKeyTypeHmac,
+						Secret: "other secret",
+					},
+				},
+			}),
+			reason: ReasonInvalidSignature,
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			entry := testSignedEntry("app.example.com.")
+			g.Expect(signer.Sign(entry)).To(gomega.Succeed())
+			if c.alter != nil {
+				c.alter(entry)
+			}
+			expectVerifyReason(g, c.verifier.Verify(entry), c.reason)
+		})
+	}
+}
+
+func TestSignerRejectsSignalOfAnotherMember(t *testing.T) {
+	g := gomega.NewWithT(t)
+	key := testSigningKeys(t)[config.SigningKeyTypeHmac]
+	signer := NewSigner(&config.SigningConfig{
+		SignKeyId: key.Id,
+		Keys:      []*config.SigningKey{key},
+	})
+	signal := &opssvc.MemberSignal{
+		Fqdn:  "app.example.com.",
+		Ip:    "10.0.0.1",
+		Level: opssvc.MemberSignal_FAILED,
+	}
+	g.Expect(signer.SignSignal(signal)).To(gomega.Succeed())
+	signal.Ip = "10.0.0.2"
+	expectVerifyReason(g, signer.VerifySignal(signal), ReasonInvalidSignature)
+}
+
+func TestSignerWithoutKeys(t *testing.T) {
+	g := gomega.NewWithT(t)
+	signer := NewSigner(nil)
+	g.Expect(signer.Enabled()).To(gomega.BeFalse())
+
+	entry := testSignedEntry("app.example.com.")
+	g.Expect(signer.Sign(entry)).To(gomega.Succeed())
+	// content hash is only used for change detection, it has no signing time
+	g.Expect(entry.GetSignature()).ToNot(gomega.BeEmpty())
+	g.Expect(SignedAt(entry).IsZero()).To(gomega.BeTrue())
+	g.Expect(signer.Verify(&entries.SignedEntry{})).To(gomega.Succeed())
+}